@@ -16,22 +16,36 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/user"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+	"github.com/gaterace/dml-go/pkg/dml"
 	"github.com/kylelemons/go-gypsy/yaml"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	flag "github.com/juju/gnuflag"
 )
@@ -58,6 +72,27 @@ var country_code = flag.String("country_code", "us", "country code")
 var phtype = flag.String("phtype", "", "phone type")
 var phone = flag.String("phone", "", "phone number")
 
+var page_size = flag.Int("page_size", 0, "page size")
+var page_token = flag.String("page_token", "", "page token")
+var order_by = flag.String("order_by", "", "sort order")
+
+var all = flag.Bool("all", false, "all parties")
+var vcard_version = flag.String("vcard_version", "4.0", "vCard version")
+var dry_run = flag.Bool("dry_run", false, "validate only")
+var mapping = flag.String("mapping", "", "CSV column mapping file")
+var children = flag.Bool("children", false, "include child records")
+var days = flag.Int("days", 0, "days since deletion")
+var as_of = flag.String("as_of", "", "local time as YYYY-MM-DD HH:MM:SS")
+var user_id = flag.String("user", "", "user id")
+var from = flag.String("from", "", "local time as YYYY-MM-DD HH:MM:SS")
+var to = flag.String("to", "", "local time as YYYY-MM-DD HH:MM:SS")
+var limit = flag.Int("limit", 0, "maximum entries")
+var grpc_status = flag.Bool("status", false, "return errors as gRPC status codes")
+var idempotency_key = flag.String("idempotency_key", "", "idempotency key for create commands")
+
+// Layout of the as_of, from and to parameters.
+const timeFormat = "2006-01-02 15:04:05"
+
 func main() {
 	flag.Parse(true)
 
@@ -92,22 +127,47 @@ func main() {
 		fmt.Printf("          --nickname <nickname> --company <company> -e <email>\n")
 		fmt.Printf("    %s update_party --id <party id>  --version <version> --ptype <party type>  --fname <first name>\n", prog)
 		fmt.Printf("          --mname <middle name>  --lname <last name> --nickname <nickname> --company <company> -e <email>\n")
+		fmt.Printf("    %s patch_party --id <party id> --version <version> [--ptype <party type>] [--fname <first name>]\n", prog)
+		fmt.Printf("          [--mname <middle name>] [--lname <last name>] [--nickname <nickname>] [--company <company>] [-e <email>]\n")
 		fmt.Printf("    %s delete_party --id <party id> --version <version>\n", prog)
 		fmt.Printf("    %s get_party --id <party id> \n", prog)
-		fmt.Printf("    %s get_parties  \n", prog)
-		fmt.Printf("    %s get_party_wrapper --id <party id> \n", prog)
+		fmt.Printf("    %s history --id <party id>\n", prog)
+		fmt.Printf("    %s trash\n", prog)
+		fmt.Printf("    %s restore --id <party id> --version <version> [--children | --atype <address type> | --phtype <phone type>]\n", prog)
+		fmt.Printf("    %s purge_deleted [--days <days since deletion>]\n", prog)
+		fmt.Printf("    %s get_parties [--page_size <page size>] [--page_token <page token>]\n", prog)
+		fmt.Printf("          [--order_by <last_name, company, created or modified>]\n")
+		fmt.Printf("    %s get_party_wrapper --id <party id> [--as_of <YYYY-MM-DD HH:MM:SS>]\n", prog)
+		fmt.Printf("    %s audit_log [--user <user id>] [--id <party id>] [--from <YYYY-MM-DD HH:MM:SS>]\n", prog)
+		fmt.Printf("          [--to <YYYY-MM-DD HH:MM:SS>] [--limit <maximum entries>]\n")
+		fmt.Printf("    %s dump <output file>\n", prog)
+		fmt.Printf("    %s create_party_wrapper <party wrapper JSON file>\n", prog)
+		fmt.Printf("    %s bulk_upsert <party wrapper file, one JSON object per line as written by dump>\n", prog)
+		fmt.Printf("    %s export_vcard [--id <party id> | --all] [--vcard_version <3.0 or 4.0>]\n", prog)
+		fmt.Printf("          [--page_size <parties per request>]\n")
+		fmt.Printf("    %s import_vcard [--dry_run] <vcf file>\n", prog)
+		fmt.Printf("    %s export_csv [--page_size <parties per request>] <csv file>\n", prog)
+		fmt.Printf("    %s import_csv [--dry_run] [--mapping <mapping file>] <csv file>\n", prog)
+		fmt.Printf("    %s search_parties [--lname <last name prefix>] [--fname <first name prefix>] [--company <company>]\n", prog)
+		fmt.Printf("          [-e <email>] [--ptype <party type>] [--phone <phone number>] [--city <city>] [--state <state>]\n")
+		fmt.Printf("          [--postal_code <postal code>]\n")
 		fmt.Printf("    %s create_address --id <party id> --atype <address type> --address1 <address 1> [--address2 <address 2>]\n", prog)
 		fmt.Printf("          --city <city> --state <state> --postal_code <postal code> [--country_code <country code>]\n")
 		fmt.Printf("    %s update_address --id <party id> --atype <address type> --version <version> --address1 <address 1> [--address2 <address 2>]\n", prog)
 		fmt.Printf("          --city <city> --state <state> --postal_code <postal code> [--country_code <country code>]\n")
+		fmt.Printf("    %s patch_address --id <party id> --atype <address type> --version <version> [--address_1 <address 1>]\n", prog)
+		fmt.Printf("          [--address_2 <address 2>] [--city <city>] [--state <state>] [--postal_code <postal code>] [--country_code <country code>]\n")
 		fmt.Printf("    %s delete_address --id <party id> --atype <address type> --version <version>\n", prog)
 		fmt.Printf("    %s get_address --id <party id> --atype <address type> \n", prog)
 		fmt.Printf("    %s create_phone --id <party id> --phtype <phone type> --phone <phone number> \n", prog)
 		fmt.Printf("    %s update_phone --id <party id> --phtype <phone type> --version <version> --phone <phone number> \n", prog)
+		fmt.Printf("    %s patch_phone --id <party id> --phtype <phone type> --version <version> --phone <phone number> \n", prog)
 		fmt.Printf("    %s delete_phone --id <party id> --phtype <phone type> --version <version> \n", prog)
 		fmt.Printf("    %s get_phone --id <party id> --phtype <phone type>  \n", prog)
 
 		fmt.Printf("    %s get_server_version\n", prog)
+		fmt.Printf("Add --status to any command to get errors as gRPC status codes instead of error_code values.\n")
+		fmt.Printf("Add --idempotency_key <key> to a create command to get the first response back when it is repeated.\n")
 
 		os.Exit(1)
 	}
@@ -180,6 +240,23 @@ func main() {
 			validParams = false
 		}
 
+	case "patch_party":
+		if *id <= 0 {
+			fmt.Println("id parameter missing")
+			validParams = false
+		}
+		if *version < 0 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if len(patchPaths(partyPatchFlags).GetPaths()) == 0 {
+			fmt.Println("no fields to patch, set one or more of ptype, lname, mname, fname, nickname, company, e")
+			validParams = false
+		}
+		if isFlagSet("ptype") && (*ptype != "person") && (*ptype != "business") {
+			fmt.Println("ptype parameter must be person or business")
+			validParams = false
+		}
 	case "delete_party":
 		if *id <= 0 {
 			fmt.Println("id parameter missing")
@@ -196,13 +273,128 @@ func main() {
 			validParams = false
 		}
 	case "get_parties":
-		// no parameters
-		validParams = true
+		if *page_size < 0 {
+			fmt.Println("page_size parameter must not be negative")
+			validParams = false
+		}
+		if (*order_by != "") && (*order_by != "last_name") && (*order_by != "company") &&
+			(*order_by != "created") && (*order_by != "modified") {
+			fmt.Println("order_by parameter must be last_name, company, created or modified")
+			validParams = false
+		}
+	case "restore":
+		if *id <= 0 {
+			fmt.Println("id parameter missing")
+			validParams = false
+		}
+		if *version < 0 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if (*atype != "") && (*atype != "home") && (*atype != "shipping") {
+			fmt.Println("atype parameter must be home or shipping")
+			validParams = false
+		}
+		if (*phtype != "") && (*phtype != "home") && (*phtype != "work") && (*phtype != "cell") {
+			fmt.Println("phtype parameter must be home, work or cell")
+			validParams = false
+		}
+		if (*atype != "") && (*phtype != "") {
+			fmt.Println("only one of atype and phtype parameters allowed")
+			validParams = false
+		}
+		if *children && ((*atype != "") || (*phtype != "")) {
+			fmt.Println("children parameter only allowed when restoring a party")
+			validParams = false
+		}
+	case "history":
+		if *id <= 0 {
+			fmt.Println("id parameter missing")
+			validParams = false
+		}
+	case "purge_deleted":
+		if *days < 0 {
+			fmt.Println("days parameter must not be negative")
+			validParams = false
+		}
 	case "get_party_wrapper":
 		if *id <= 0 {
 			fmt.Println("id parameter missing")
 			validParams = false
 		}
+		if (*as_of != "") && (localDateTime(*as_of) == nil) {
+			fmt.Println("as_of parameter must be YYYY-MM-DD HH:MM:SS")
+			validParams = false
+		}
+	case "audit_log":
+		if (*from != "") && (localDateTime(*from) == nil) {
+			fmt.Println("from parameter must be YYYY-MM-DD HH:MM:SS")
+			validParams = false
+		}
+		if (*to != "") && (localDateTime(*to) == nil) {
+			fmt.Println("to parameter must be YYYY-MM-DD HH:MM:SS")
+			validParams = false
+		}
+		if *limit < 0 {
+			fmt.Println("limit parameter must not be negative")
+			validParams = false
+		}
+	case "dump":
+		if flag.Arg(1) == "" {
+			fmt.Println("output file parameter missing")
+			validParams = false
+		}
+	case "create_party_wrapper":
+		if flag.Arg(1) == "" {
+			fmt.Println("input file parameter missing")
+			validParams = false
+		}
+	case "bulk_upsert":
+		if flag.Arg(1) == "" {
+			fmt.Println("input file parameter missing")
+			validParams = false
+		}
+	case "export_vcard":
+		if (*id <= 0) && !*all {
+			fmt.Println("id or all parameter missing")
+			validParams = false
+		}
+		if (*id > 0) && *all {
+			fmt.Println("only one of id and all parameters allowed")
+			validParams = false
+		}
+		if (*vcard_version != "3.0") && (*vcard_version != "4.0") {
+			fmt.Println("vcard_version parameter must be 3.0 or 4.0")
+			validParams = false
+		}
+		if *page_size < 0 {
+			fmt.Println("page_size parameter must not be negative")
+			validParams = false
+		}
+	case "import_vcard":
+		if flag.Arg(1) == "" {
+			fmt.Println("vcf file parameter missing")
+			validParams = false
+		}
+	case "export_csv":
+		if flag.Arg(1) == "" {
+			fmt.Println("csv file parameter missing")
+			validParams = false
+		}
+		if *page_size < 0 {
+			fmt.Println("page_size parameter must not be negative")
+			validParams = false
+		}
+	case "import_csv":
+		if flag.Arg(1) == "" {
+			fmt.Println("csv file parameter missing")
+			validParams = false
+		}
+	case "search_parties":
+		if (*ptype != "") && (*ptype != "person") && (*ptype != "business") {
+			fmt.Println("ptype parameter must be person or business")
+			validParams = false
+		}
 	case "create_address":
 		if *id <= 0 {
 			fmt.Println("id parameter missing")
@@ -265,6 +457,23 @@ func main() {
 			fmt.Println("country_code parameter must be 2 character country code")
 			validParams = false
 		}
+	case "patch_address":
+		if *id <= 0 {
+			fmt.Println("id parameter missing")
+			validParams = false
+		}
+		if (*atype != "home") && (*atype != "shipping") {
+			fmt.Println("atype parameter missing, must be home or shipping")
+			validParams = false
+		}
+		if *version < 0 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if len(patchPaths(addressPatchFlags).GetPaths()) == 0 {
+			fmt.Println("no fields to patch, set one or more of address_1, address_2, city, state, postal_code, country_code")
+			validParams = false
+		}
 	case "delete_address":
 		if *id <= 0 {
 			fmt.Println("id parameter missing")
@@ -317,6 +526,23 @@ func main() {
 			fmt.Println("phone parameter missing")
 			validParams = false
 		}
+	case "patch_phone":
+		if *id <= 0 {
+			fmt.Println("id parameter missing")
+			validParams = false
+		}
+		if (*phtype != "home") && (*phtype != "work") && (*phtype != "cell") {
+			fmt.Println("phtype parameter missing, must be home, work or cell")
+			validParams = false
+		}
+		if *version < 0 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *phone == "" {
+			fmt.Println("phone parameter missing")
+			validParams = false
+		}
 	case "delete_phone":
 		if *id <= 0 {
 			fmt.Println("id parameter missing")
@@ -402,6 +628,12 @@ func main() {
 	}
 
 	md := metadata.Pairs("token", savedToken)
+	if *grpc_status {
+		md.Append("status-codes", "grpc")
+	}
+	if *idempotency_key != "" {
+		md.Append("idempotency-key", *idempotency_key)
+	}
 	mctx := metadata.NewOutgoingContext(ctx, md)
 
 	switch cmd {
@@ -440,6 +672,12 @@ func main() {
 		req.Company = *company
 		req.Email = *email
 		resp, err := client.UpdateParty(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentParty()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.UpdateParty(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
 		printResponse(resp, err)
 
 	case "get_server_version":
@@ -447,11 +685,41 @@ func main() {
 		req.DummyParam = 1
 		resp, err := client.GetServerVersion(mctx, &req)
 		printResponse(resp, err)
+	case "patch_party":
+		req := pb.PatchPartyRequest{}
+		req.PartyId = *id
+		req.Version = int32(*version)
+		if *ptype == "person" {
+			req.PartyType = 1
+		} else if *ptype == "business" {
+			req.PartyType = 2
+		}
+		req.FirstName = *fname
+		req.MiddleName = *mname
+		req.LastName = *lname
+		req.Nickname = *nickname
+		req.Company = *company
+		req.Email = *email
+		req.UpdateMask = patchPaths(partyPatchFlags)
+		resp, err := client.PatchParty(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentParty()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.PatchParty(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
+		printResponse(resp, err)
 	case "delete_party":
 		req := pb.DeletePartyRequest{}
 		req.PartyId = *id
 		req.Version = int32(*version)
 		resp, err := client.DeleteParty(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentParty()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.DeleteParty(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
 		printResponse(resp, err)
 	case "get_party":
 		req := pb.GetPartyRequest{}
@@ -460,13 +728,219 @@ func main() {
 		printResponse(resp, err)
 	case "get_parties":
 		req := pb.GetPartiesRequest{}
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		req.OrderBy = *order_by
 		resp, err := client.GetParties(mctx, &req)
 		printResponse(resp, err)
+	case "history":
+		req := pb.GetPartyHistoryRequest{}
+		req.PartyId = *id
+		resp, err := client.GetPartyHistory(mctx, &req)
+		printResponse(resp, err)
+	case "trash":
+		req := pb.GetDeletedPartiesRequest{}
+		resp, err := client.GetDeletedParties(mctx, &req)
+		printResponse(resp, err)
+	case "restore":
+		if *atype != "" {
+			req := pb.RestoreAddressRequest{}
+			req.PartyId = *id
+			if *atype == "home" {
+				req.AddressType = 1
+			} else if *atype == "shipping" {
+				req.AddressType = 2
+			}
+			req.Version = int32(*version)
+			resp, err := client.RestoreAddress(mctx, &req)
+			conflict := conflictResponse(resp, err)
+			// only a deleted record can be restored at its current version
+			for (conflict.GetErrorCode() == 412) && conflict.GetCurrentAddress().GetIsDeleted() &&
+				confirmRetry(&req, conflict.GetCurrentAddress()) {
+				req.Version = conflict.GetCurrentVersion()
+				resp, err = client.RestoreAddress(mctx, &req)
+				conflict = conflictResponse(resp, err)
+			}
+			printResponse(resp, err)
+		} else if *phtype != "" {
+			req := pb.RestorePhoneRequest{}
+			req.PartyId = *id
+			if *phtype == "home" {
+				req.PhoneType = 1
+			} else if *phtype == "work" {
+				req.PhoneType = 2
+			} else if *phtype == "cell" {
+				req.PhoneType = 3
+			}
+			req.Version = int32(*version)
+			resp, err := client.RestorePhone(mctx, &req)
+			conflict := conflictResponse(resp, err)
+			// only a deleted record can be restored at its current version
+			for (conflict.GetErrorCode() == 412) && conflict.GetCurrentPhone().GetIsDeleted() &&
+				confirmRetry(&req, conflict.GetCurrentPhone()) {
+				req.Version = conflict.GetCurrentVersion()
+				resp, err = client.RestorePhone(mctx, &req)
+				conflict = conflictResponse(resp, err)
+			}
+			printResponse(resp, err)
+		} else {
+			req := pb.RestorePartyRequest{}
+			req.PartyId = *id
+			req.Version = int32(*version)
+			req.RestoreChildren = *children
+			resp, err := client.RestoreParty(mctx, &req)
+			conflict := conflictResponse(resp, err)
+			// only a deleted record can be restored at its current version
+			for (conflict.GetErrorCode() == 412) && conflict.GetCurrentParty().GetIsDeleted() &&
+				confirmRetry(&req, conflict.GetCurrentParty()) {
+				req.Version = conflict.GetCurrentVersion()
+				resp, err = client.RestoreParty(mctx, &req)
+				conflict = conflictResponse(resp, err)
+			}
+			printResponse(resp, err)
+		}
+	case "purge_deleted":
+		req := pb.PurgeDeletedRequest{}
+		req.OlderThanDays = int32(*days)
+		resp, err := client.PurgeDeleted(mctx, &req)
+		printResponse(resp, err)
 	case "get_party_wrapper":
 		req := pb.GetPartyWrapperRequest{}
 		req.PartyId = *id
+		req.AsOf = localDateTime(*as_of)
 		resp, err := client.GetPartyWrapper(mctx, &req)
 		printResponse(resp, err)
+	case "audit_log":
+		req := pb.QueryAuditLogRequest{}
+		req.UserId = *user_id
+		req.PartyId = *id
+		req.LoggedFrom = localDateTime(*from)
+		req.LoggedTo = localDateTime(*to)
+		req.Limit = int32(*limit)
+		resp, err := client.QueryAuditLog(mctx, &req)
+		printResponse(resp, err)
+	case "dump":
+		req := pb.StreamPartyWrappersRequest{}
+		stream, err := client.StreamPartyWrappers(mctx, &req)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			os.Exit(1)
+		}
+		count, err := dumpPartyWrappers(stream, flag.Arg(1))
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("wrote %d party wrappers to %s\n", count, flag.Arg(1))
+	case "create_party_wrapper":
+		data, err := ioutil.ReadFile(flag.Arg(1))
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			os.Exit(1)
+		}
+		req := pb.CreatePartyWrapperRequest{}
+		err = json.Unmarshal(data, &req)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			os.Exit(1)
+		}
+		resp, err := client.CreatePartyWrapper(mctx, &req)
+		printResponse(resp, err)
+	case "bulk_upsert":
+		stream, err := client.BulkUpsertPartyWrappers(mctx)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			os.Exit(1)
+		}
+		resp, err := upsertPartyWrappers(stream, flag.Arg(1))
+		printResponse(resp, err)
+	case "export_vcard":
+		req := pb.ExportVcardRequest{}
+		req.PartyId = *id
+		req.VcardVersion = *vcard_version
+		req.PageSize = int32(*page_size)
+		for {
+			resp, err := client.ExportVcard(mctx, &req)
+			if (err != nil) || (resp.GetErrorCode() != 0) {
+				printResponse(resp, err)
+				break
+			}
+			os.Stdout.Write(resp.GetVcardData())
+			if resp.GetNextPageToken() == "" {
+				break
+			}
+			req.PageToken = resp.GetNextPageToken()
+		}
+	case "import_vcard":
+		data, err := ioutil.ReadFile(flag.Arg(1))
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			os.Exit(1)
+		}
+		req := pb.ImportVcardRequest{}
+		req.VcardData = data
+		req.DryRun = *dry_run
+		resp, err := client.ImportVcard(mctx, &req)
+		printResponse(resp, err)
+	case "export_csv":
+		req := pb.ExportCsvRequest{}
+		req.PageSize = int32(*page_size)
+		var data []byte
+		var rows int32
+		for {
+			resp, err := client.ExportCsv(mctx, &req)
+			if (err != nil) || (resp.GetErrorCode() != 0) {
+				printResponse(resp, err)
+				os.Exit(1)
+			}
+			data = append(data, resp.GetCsvData()...)
+			rows += resp.GetRowCount()
+			if resp.GetNextPageToken() == "" {
+				break
+			}
+			req.PageToken = resp.GetNextPageToken()
+		}
+		err := ioutil.WriteFile(flag.Arg(1), data, 0644)
+		if err == nil {
+			fmt.Printf("wrote %d rows to %s\n", rows, flag.Arg(1))
+		} else {
+			fmt.Printf("err: %s\n", err)
+		}
+	case "import_csv":
+		data, err := ioutil.ReadFile(flag.Arg(1))
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			os.Exit(1)
+		}
+		req := pb.ImportCsvRequest{}
+		req.CsvData = data
+		req.DryRun = *dry_run
+		if *mapping != "" {
+			req.ColumnMap, err = readColumnMap(*mapping)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+				os.Exit(1)
+			}
+		}
+		resp, err := client.ImportCsv(mctx, &req)
+		printResponse(resp, err)
+	case "search_parties":
+		req := pb.SearchPartiesRequest{}
+		if *ptype == "person" {
+			req.PartyType = 1
+		} else if *ptype == "business" {
+			req.PartyType = 2
+		}
+		req.LastName = *lname
+		req.FirstName = *fname
+		req.Company = *company
+		req.Email = *email
+		req.PhoneNumber = *phone
+		req.City = *city
+		req.State = *state
+		req.PostalCode = *postal_code
+		resp, err := client.SearchParties(mctx, &req)
+		printResponse(resp, err)
 	case "create_address":
 		req := pb.CreateAddressRequest{}
 		req.PartyId = *id
@@ -499,6 +973,36 @@ func main() {
 		req.PostalCode = *postal_code
 		req.CountryCode = *country_code
 		resp, err := client.UpdateAddress(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentAddress()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.UpdateAddress(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
+		printResponse(resp, err)
+	case "patch_address":
+		req := pb.PatchAddressRequest{}
+		req.PartyId = *id
+		if *atype == "home" {
+			req.AddressType = 1
+		} else if *atype == "shipping" {
+			req.AddressType = 2
+		}
+		req.Version = int32(*version)
+		req.Address_1 = *address_1
+		req.Address_2 = *address_2
+		req.City = *city
+		req.State = *state
+		req.PostalCode = *postal_code
+		req.CountryCode = *country_code
+		req.UpdateMask = patchPaths(addressPatchFlags)
+		resp, err := client.PatchAddress(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentAddress()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.PatchAddress(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
 		printResponse(resp, err)
 	case "delete_address":
 		req := pb.DeleteAddressRequest{}
@@ -510,6 +1014,12 @@ func main() {
 		}
 		req.Version = int32(*version)
 		resp, err := client.DeleteAddress(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentAddress()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.DeleteAddress(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
 		printResponse(resp, err)
 	case "get_address":
 		req := pb.GetAddressRequest{}
@@ -547,6 +1057,33 @@ func main() {
 		req.PhoneNumber = *phone
 		req.Version = int32(*version)
 		resp, err := client.UpdatePhone(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentPhone()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.UpdatePhone(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
+		printResponse(resp, err)
+	case "patch_phone":
+		req := pb.PatchPhoneRequest{}
+		req.PartyId = *id
+		if *phtype == "home" {
+			req.PhoneType = 1
+		} else if *phtype == "work" {
+			req.PhoneType = 2
+		} else if *phtype == "cell" {
+			req.PhoneType = 3
+		}
+		req.PhoneNumber = *phone
+		req.Version = int32(*version)
+		req.UpdateMask = patchPaths(phonePatchFlags)
+		resp, err := client.PatchPhone(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentPhone()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.PatchPhone(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
 		printResponse(resp, err)
 	case "delete_phone":
 		req := pb.DeletePhoneRequest{}
@@ -560,6 +1097,12 @@ func main() {
 		}
 		req.Version = int32(*version)
 		resp, err := client.DeletePhone(mctx, &req)
+		conflict := conflictResponse(resp, err)
+		for (conflict.GetErrorCode() == 412) && confirmRetry(&req, conflict.GetCurrentPhone()) {
+			req.Version = conflict.GetCurrentVersion()
+			resp, err = client.DeletePhone(mctx, &req)
+			conflict = conflictResponse(resp, err)
+		}
 		printResponse(resp, err)
 	case "get_phone":
 		req := pb.GetPhoneRequest{}
@@ -589,5 +1132,225 @@ func printResponse(resp interface{}, err error) {
 	}
 	if err != nil {
 		fmt.Printf("err: %s\n", err)
+		for _, detail := range status.Convert(err).Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.GetFieldViolations() {
+					fmt.Printf("    %s: %s\n", violation.GetField(), violation.GetDescription())
+				}
+			}
+		}
+	}
+}
+
+// Helper to get the response of a call, or for a version conflict returned as a FailedPrecondition
+// status error, the response message in the status details, with the current version and record.
+func conflictResponse[T proto.Message](resp T, err error) T {
+	if status.Code(err) != codes.FailedPrecondition {
+		return resp
+	}
+
+	for _, detail := range status.Convert(err).Details() {
+		if conflict, ok := detail.(T); ok {
+			return conflict
+		}
+	}
+
+	return resp
+}
+
+// Record fields the server manages, or sets from the JWT, which a request does not carry.
+var serverFields = map[string]bool{"mservice_id": true, "version": true, "created": true, "modified": true,
+	"deleted": true, "is_deleted": true}
+
+// Helper to show how the current server record differs from a request that got a version conflict, and
+// ask whether to retry the request at the current version.
+func confirmRetry(req proto.Message, current proto.Message) bool {
+	sent := protoFields(req)
+	server := protoFields(current)
+
+	fmt.Printf("version conflict: the server record is at version %v\n", server["version"])
+
+	var names []string
+	for name := range sent {
+		names = append(names, name)
 	}
+
+	// a patch only sends the fields in its update mask
+	if patch, ok := req.(interface{ GetUpdateMask() *fieldmaskpb.FieldMask }); ok {
+		names = patch.GetUpdateMask().GetPaths()
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if serverFields[name] || strings.HasSuffix(name, "_type_name") {
+			continue
+		}
+
+		value, ok := server[name]
+		if ok && !reflect.DeepEqual(value, sent[name]) {
+			fmt.Printf("    %s: server %v, yours %v\n", name, value, sent[name])
+		}
+	}
+
+	fmt.Printf("retry with version %v? [y/N] ", server["version"])
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(answer)), "y")
+}
+
+// Helper to get the fields of a message by proto name, including unset ones.
+func protoFields(msg proto.Message) map[string]interface{} {
+	fields := make(map[string]interface{})
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg)
+	if err == nil {
+		json.Unmarshal(data, &fields)
+	}
+
+	return fields
+}
+
+// Command line flags for the fields a patch can update, with the proto field name of each.
+var partyPatchFlags = [][2]string{{"ptype", "party_type"}, {"lname", "last_name"}, {"mname", "middle_name"},
+	{"fname", "first_name"}, {"nickname", "nickname"}, {"company", "company"}, {"e", "email"}}
+var addressPatchFlags = [][2]string{{"address_1", "address_1"}, {"address_2", "address_2"}, {"city", "city"},
+	{"state", "state"}, {"postal_code", "postal_code"}, {"country_code", "country_code"}}
+var phonePatchFlags = [][2]string{{"phone", "phone_number"}}
+
+// Helper to get the update mask of a patch, naming the fields whose flags are set on the command line.
+func patchPaths(patchFlags [][2]string) *fieldmaskpb.FieldMask {
+	mask := &fieldmaskpb.FieldMask{}
+	for _, patchFlag := range patchFlags {
+		if isFlagSet(patchFlag[0]) {
+			mask.Paths = append(mask.Paths, patchFlag[1])
+		}
+	}
+
+	return mask
+}
+
+// Helper to check if a flag is set on the command line, even to its default value.
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
+
+// Helper to convert a local time parameter to a dml.DateTime, nil if empty or not YYYY-MM-DD HH:MM:SS.
+func localDateTime(value string) *dml.DateTime {
+	t, err := time.ParseInLocation(timeFormat, value, time.Local)
+	if err != nil {
+		return nil
+	}
+
+	return dml.DateTimeFromTime(t)
+}
+
+// Helper to write a party wrapper stream to a file, one JSON object per line.
+func dumpPartyWrappers(stream pb.MServiceAddrbook_StreamPartyWrappersClient, fileName string) (int, error) {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+
+	count := 0
+	encoder := json.NewEncoder(file)
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		if resp.GetErrorCode() != 0 {
+			return count, fmt.Errorf("%d %s", resp.GetErrorCode(), resp.GetErrorMessage())
+		}
+
+		err = encoder.Encode(resp.GetPartyWrapper())
+		if err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, file.Close()
+}
+
+// Helper to send the party wrappers in a file, one JSON object per line, to a bulk upsert stream.
+func upsertPartyWrappers(stream pb.MServiceAddrbook_BulkUpsertPartyWrappersClient, fileName string) (*pb.BulkUpsertPartyWrappersResponse, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+
+	for {
+		wrap := pb.PartyWrapper{}
+		err = decoder.Decode(&wrap)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = stream.Send(&wrap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// Helper to read a CSV column mapping file, with lines of source column, target column
+// and optional label column; lines starting with # are comments.
+func readColumnMap(fileName string) ([]*pb.CsvColumnMap, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var columnMap []*pb.CsvColumnMap
+
+	for _, record := range records {
+		if (len(record) < 2) || (len(record) > 3) {
+			return nil, fmt.Errorf("%s: mapping lines must have a source, target and optional label column", fileName)
+		}
+
+		m := &pb.CsvColumnMap{Source: record[0], Target: record[1]}
+		if len(record) == 3 {
+			m.Label = record[2]
+		}
+
+		columnMap = append(columnMap, m)
+	}
+
+	return columnMap, nil
 }
@@ -16,17 +16,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 
 	"github.com/gaterace/addrbook/pkg/addrauth"
 	"github.com/gaterace/addrbook/pkg/addrservice"
+	"github.com/gaterace/addrbook/pkg/addrstore"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -48,6 +52,17 @@ func main() {
 		RunE:    cli.run,
 	}
 
+	migrateCmd := &cobra.Command{
+		Use:       "migrate up|down|status",
+		Short:     "Apply, revert or show database schema migrations",
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: []string{"up", "down", "status"},
+		PreRunE:   cli.setupConfig,
+		RunE:      cli.migrate,
+	}
+
+	cmd.AddCommand(migrateCmd)
+
 	if err := setupFlags(cmd); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -72,26 +87,52 @@ type cfg struct {
 	KeyFile     string
 	Tls         bool
 	Port        int
+	DbDriver    string
 	DbUser      string
 	DbPwd       string
 	DbTransport string
+	DbPath      string
+	DbFixture   string
 	JwtPubFile  string
+	// days to keep deleted records, 0 to keep them forever
+	PurgeAfterDays int
+	PurgeInterval  time.Duration
+	PurgeBatchSize int
+	// write every call to the audit log
+	AuditLog bool
+	// return failed calls as gRPC status errors
+	GrpcStatusCodes bool
+	// how long create responses are kept for idempotency keys, 0 to ignore keys
+	IdempotencyWindow time.Duration
+	// idempotency windows for particular mservice accounts
+	IdempotencyWindows map[int64]time.Duration
 }
 
 func setupFlags(cmd *cobra.Command) error {
-
-	cmd.Flags().String("conf", "conf.yaml", "Path to inventory config file.")
-	cmd.Flags().String("log_file", "", "Path to log file.")
-	cmd.Flags().String("cert_file", "", "Path to certificate file.")
-	cmd.Flags().String("key_file", "", "Path to certificate key file.")
-	cmd.Flags().Bool("tls", false, "Use tls for connection.")
-	cmd.Flags().Int("port", 50057, "Port for RPC connections")
-	cmd.Flags().String("db_user", "", "Database user name.")
-	cmd.Flags().String("db_pwd", "", "Database user password.")
-	cmd.Flags().String("db_transport", "", "Database transport string.")
-	cmd.Flags().String("jwt_pub_file", "", "Path to JWT public certificate.")
-
-	return viper.BindPFlags(cmd.Flags())
+	// persistent, so the flags also apply to the migrate subcommand
+	flags := cmd.PersistentFlags()
+
+	flags.String("conf", "conf.yaml", "Path to inventory config file.")
+	flags.String("log_file", "", "Path to log file.")
+	flags.String("cert_file", "", "Path to certificate file.")
+	flags.String("key_file", "", "Path to certificate key file.")
+	flags.Bool("tls", false, "Use tls for connection.")
+	flags.Int("port", 50057, "Port for RPC connections")
+	flags.String("db_driver", "mysql", "Database driver, one of mysql, postgres, sqlite or memory.")
+	flags.String("db_user", "", "Database user name.")
+	flags.String("db_pwd", "", "Database user password.")
+	flags.String("db_transport", "", "Database transport string.")
+	flags.String("db_path", "addrbook.db", "Path to database file for sqlite.")
+	flags.String("db_fixture", "", "Path to JSON fixture file to seed memory database.")
+	flags.String("jwt_pub_file", "", "Path to JWT public certificate.")
+	flags.Int("purge_after_days", 0, "Days to keep deleted records before purging, 0 to keep them.")
+	flags.Duration("purge_interval", 24*time.Hour, "Interval between purges of deleted records.")
+	flags.Int("purge_batch_size", 500, "Rows purged from each table per batch.")
+	flags.Bool("audit_log", false, "Write every call to the audit log.")
+	flags.Bool("grpc_status_codes", false, "Return failed calls as gRPC status errors instead of error codes.")
+	flags.Duration("idempotency_window", 24*time.Hour, "Time to keep create responses for idempotency keys, 0 to ignore keys.")
+
+	return viper.BindPFlags(flags)
 }
 
 func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
@@ -117,10 +158,35 @@ func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
 	c.cfg.KeyFile = viper.GetString("key_file")
 	c.cfg.Tls = viper.GetBool("tls")
 	c.cfg.Port = viper.GetInt("port")
+	c.cfg.DbDriver = viper.GetString("db_driver")
 	c.cfg.DbUser = viper.GetString("db_user")
 	c.cfg.DbPwd = viper.GetString("db_pwd")
 	c.cfg.DbTransport = viper.GetString("db_transport")
+	c.cfg.DbPath = viper.GetString("db_path")
+	c.cfg.DbFixture = viper.GetString("db_fixture")
 	c.cfg.JwtPubFile = viper.GetString("jwt_pub_file")
+	c.cfg.PurgeAfterDays = viper.GetInt("purge_after_days")
+	c.cfg.PurgeInterval = viper.GetDuration("purge_interval")
+	c.cfg.PurgeBatchSize = viper.GetInt("purge_batch_size")
+	c.cfg.AuditLog = viper.GetBool("audit_log")
+	c.cfg.GrpcStatusCodes = viper.GetBool("grpc_status_codes")
+	c.cfg.IdempotencyWindow = viper.GetDuration("idempotency_window")
+
+	// per account windows are only set in the config file, as mservice id: duration
+	c.cfg.IdempotencyWindows = make(map[int64]time.Duration)
+	for account, value := range viper.GetStringMapString("idempotency_windows") {
+		mserviceId, err := strconv.ParseInt(account, 10, 64)
+		if err != nil {
+			return fmt.Errorf("idempotency_windows: invalid mservice id %s", account)
+		}
+
+		window, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("idempotency_windows: invalid window %s for mservice id %s", value, account)
+		}
+
+		c.cfg.IdempotencyWindows[mserviceId] = window
+	}
 
 	return nil
 }
@@ -133,10 +199,20 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	key_file := c.cfg.KeyFile
 	tls := c.cfg.Tls
 	port := c.cfg.Port
+	db_driver := c.cfg.DbDriver
 	db_user := c.cfg.DbUser
 	db_pwd := c.cfg.DbPwd
 	db_transport := c.cfg.DbTransport
+	db_path := c.cfg.DbPath
+	db_fixture := c.cfg.DbFixture
 	jwt_pub_file := c.cfg.JwtPubFile
+	purge_after_days := c.cfg.PurgeAfterDays
+	purge_interval := c.cfg.PurgeInterval
+	purge_batch_size := c.cfg.PurgeBatchSize
+	audit_log := c.cfg.AuditLog
+	grpc_status_codes := c.cfg.GrpcStatusCodes
+	idempotency_window := c.cfg.IdempotencyWindow
+	idempotency_windows := c.cfg.IdempotencyWindows
 
 	var logWriter io.Writer
 
@@ -155,9 +231,21 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	level.Info(logger).Log("key_file", key_file)
 	level.Info(logger).Log("tls", tls)
 	level.Info(logger).Log("port", port)
+	level.Info(logger).Log("db_driver", db_driver)
 	level.Info(logger).Log("db_user", db_user)
 	level.Info(logger).Log("db_transport", db_transport)
+	level.Info(logger).Log("db_path", db_path)
+	level.Info(logger).Log("db_fixture", db_fixture)
 	level.Info(logger).Log("jwt_pub_file", jwt_pub_file)
+	level.Info(logger).Log("purge_after_days", purge_after_days)
+	level.Info(logger).Log("purge_interval", purge_interval)
+	level.Info(logger).Log("purge_batch_size", purge_batch_size)
+	level.Info(logger).Log("audit_log", audit_log)
+	level.Info(logger).Log("grpc_status_codes", grpc_status_codes)
+	level.Info(logger).Log("idempotency_window", idempotency_window)
+	for mserviceId, window := range idempotency_windows {
+		level.Info(logger).Log("idempotency_window", window, "mservice_id", mserviceId)
+	}
 
 	listen_port := ":" + strconv.Itoa(int(port))
 
@@ -177,24 +265,51 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 		opts = []grpc.ServerOption{grpc.Creds(creds)}
 	}
 
-	s := grpc.NewServer(opts...)
-
 	addrService := addrservice.NewAddrService()
 
-	sqlDb, err := SetupDatabaseConnections(db_user, db_pwd, db_transport)
+	sqlDb, err := SetupDatabaseConnections(db_driver, db_user, db_pwd, db_transport, db_path)
 	if err != nil {
 		level.Error(logger).Log("what", "SetupDatabaseConnections", "error", err)
 		os.Exit(1)
 	}
 
+	store, err := SetupStore(db_driver, sqlDb, db_fixture)
+	if err != nil {
+		level.Error(logger).Log("what", "SetupStore", "error", err)
+		os.Exit(1)
+	}
+
+	err = SetupSchema(db_driver, store, logger)
+	if err != nil {
+		level.Error(logger).Log("what", "SetupSchema", "error", err)
+		os.Exit(1)
+	}
+
 	addrService.SetLogger(logger)
-	addrService.SetDatabaseConnection(sqlDb)
+	addrService.SetStore(store)
+	addrService.SetPurgePolicy(purge_after_days, purge_batch_size)
+	addrService.SetIdempotencyWindows(idempotency_window, idempotency_windows)
+
+	// the background purgers stop when the server does
+	purgeCtx, stopPurgers := context.WithCancel(context.Background())
+	defer stopPurgers()
+
+	addrService.StartPurger(purgeCtx, purge_interval)
+	addrService.StartIdempotencyPurger(purgeCtx, purge_interval)
 
 	addrAuth := addrauth.NewAddrAuth(addrService)
 	addrAuth.SetLogger(logger)
+	if audit_log {
+		addrAuth.SetAuditLog(store)
+	}
+	addrAuth.SetStatusCodes(grpc_status_codes)
 
 	addrAuth.SetPublicKey(jwt_pub_file)
 	addrAuth.SetDatabaseConnection(sqlDb)
+
+	opts = append(opts, addrAuth.ServerOptions()...)
+	s := grpc.NewServer(opts...)
+
 	err = addrAuth.NewApiServer(s)
 	if err != nil {
 		level.Error(logger).Log("what", "NewApiServer", "error", err)
@@ -215,18 +330,153 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 }
 
 // Helper to set up the database connection.
-func SetupDatabaseConnections(db_user string, db_pwd string, db_transport string) (*sql.DB, error) {
+func SetupDatabaseConnections(db_driver string, db_user string, db_pwd string, db_transport string,
+	db_path string) (*sql.DB, error) {
 	var sqlDb *sql.DB
-	endpoint := db_user + ":" + db_pwd + "@" + db_transport + "/addrbook"
 	var err error
-	sqlDb, err = sql.Open("mysql", endpoint)
-	if err == nil {
-		err = sqlDb.Ping()
-		if err != nil {
-			sqlDb = nil
+
+	switch db_driver {
+	case "mysql":
+		endpoint := db_user + ":" + db_pwd + "@" + db_transport + "/addrbook"
+		sqlDb, err = sql.Open("mysql", endpoint)
+		if err == nil {
+			err = sqlDb.Ping()
+			if err != nil {
+				sqlDb = nil
+			}
+
 		}
+	case "postgres":
+		endpoint := "user=" + quoteConnValue(db_user) + " password=" + quoteConnValue(db_pwd) +
+			" dbname=addrbook " + db_transport
+		sqlDb, err = sql.Open("postgres", endpoint)
+		if err == nil {
+			err = sqlDb.Ping()
+			if err != nil {
+				sqlDb = nil
+			}
 
+		}
+	case "sqlite":
+		sqlDb, err = addrstore.OpenSqliteDatabase(db_path)
+	case "memory":
+		// no database connection
+	default:
+		err = fmt.Errorf("unknown db_driver: %s", db_driver)
 	}
 
 	return sqlDb, err
 }
+
+// Helper to set up the storage backend for the database connection.
+func SetupStore(db_driver string, sqlDb *sql.DB, db_fixture string) (addrstore.Store, error) {
+	switch db_driver {
+	case "mysql":
+		return addrstore.NewMysqlStore(sqlDb), nil
+	case "postgres":
+		return addrstore.NewPostgresStore(sqlDb), nil
+	case "sqlite":
+		return addrstore.NewSqliteStore(sqlDb), nil
+	case "memory":
+		store := addrstore.NewMemoryStore()
+		if db_fixture != "" {
+			if err := store.LoadFixture(db_fixture); err != nil {
+				return nil, err
+			}
+		}
+		return store, nil
+	}
+
+	return nil, fmt.Errorf("unknown db_driver: %s", db_driver)
+}
+
+// Helper to check the database schema version before serving. The sqlite database is
+// self-contained, so any pending migrations are applied to it first.
+func SetupSchema(db_driver string, store addrstore.Store, logger log.Logger) error {
+	sqlStore, ok := store.(*addrstore.SqlStore)
+	if !ok {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	if db_driver == "sqlite" {
+		applied, err := sqlStore.MigrateUp(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(applied) > 0 {
+			level.Info(logger).Log("msg", "applied schema migrations", "versions", fmt.Sprint(applied))
+		}
+	}
+
+	return sqlStore.CheckSchemaVersion(ctx)
+}
+
+// Run the migrate subcommand.
+func (c *cli) migrate(cmd *cobra.Command, args []string) error {
+	sqlDb, err := SetupDatabaseConnections(c.cfg.DbDriver, c.cfg.DbUser, c.cfg.DbPwd, c.cfg.DbTransport, c.cfg.DbPath)
+	if err != nil {
+		return err
+	}
+
+	store, err := SetupStore(c.cfg.DbDriver, sqlDb, "")
+	if err != nil {
+		return err
+	}
+
+	defer store.Close()
+
+	sqlStore, ok := store.(*addrstore.SqlStore)
+	if !ok {
+		return fmt.Errorf("db_driver %s does not use schema migrations", c.cfg.DbDriver)
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := sqlStore.MigrateUp(ctx)
+		for _, version := range applied {
+			fmt.Printf("applied migration %d\n", version)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+	case "down":
+		version, err := sqlStore.MigrateDown(ctx)
+		if err != nil {
+			return err
+		}
+		if version == 0 {
+			fmt.Println("no migrations to revert")
+		} else {
+			fmt.Printf("reverted migration %d\n", version)
+		}
+	case "status":
+		status, err := sqlStore.MigrationStatus(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range status {
+			state := "pending"
+			if m.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%04d %-30s %s\n", m.Version, m.Name, state)
+		}
+	}
+
+	return nil
+}
+
+// Helper to quote a value in a PostgreSQL key=value connection string.
+func quoteConnValue(val string) string {
+	val = strings.ReplaceAll(val, `\`, `\\`)
+	val = strings.ReplaceAll(val, "'", `\'`)
+	return "'" + val + "'"
+}
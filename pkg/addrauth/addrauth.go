@@ -12,7 +12,9 @@
 // limitations under the License.
 
 // The addrauth package provide authorization for each gRPC method in MServiceAddrbook.
-// The JWT extracted from the gRPC request context is used for each delegating method.
+// Unary and stream interceptors check the JWT extracted from the gRPC request context against a
+// per-method policy table, scope the request to the mservice account of the JWT, and log and audit
+// each call.
 
 package addrauth
 
@@ -21,10 +23,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
@@ -32,6 +39,8 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/golang-jwt/jwt"
 
+	"github.com/gaterace/addrbook/pkg/addrservice"
+	"github.com/gaterace/addrbook/pkg/addrstore"
 	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
 
 	"crypto/rsa"
@@ -45,12 +54,21 @@ const (
 	tokenExpiredMessage = "token is expired"
 )
 
+// Claim levels of the addrsvc claim in the JWT.
+const (
+	claimAdmin     = "addradmin"
+	claimReadWrite = "addrrw"
+	claimReadOnly  = "addrro"
+)
+
 type AddrAuth struct {
-	pb.UnimplementedMServiceAddrbookServer
 	logger          log.Logger
 	db              *sql.DB
 	rsaPSSPublicKey *rsa.PublicKey
 	addrService     pb.MServiceAddrbookServer
+	auditLog        addrstore.AuditLog
+	// return gRPC status codes for every call, not just those asking for them
+	statusCodes bool
 }
 
 // Get a new AddrAuth instance.
@@ -70,6 +88,17 @@ func (s *AddrAuth) SetDatabaseConnection(sqlDB *sql.DB) {
 	s.db = sqlDB
 }
 
+// Set the audit log for the AddrAuth instance; every call is written to it if set.
+func (s *AddrAuth) SetAuditLog(auditLog addrstore.AuditLog) {
+	s.auditLog = auditLog
+}
+
+// Set the AddrAuth instance to return failed calls as gRPC status errors instead of responses with an
+// error code. Each call can also ask for status errors with the status-codes: grpc metadata.
+func (s *AddrAuth) SetStatusCodes(statusCodes bool) {
+	s.statusCodes = statusCodes
+}
+
 // Set the public RSA key for the AddrAuth instance, used to validate JWT.
 func (s *AddrAuth) SetPublicKey(publicKeyFile string) error {
 	publicKey, err := ioutil.ReadFile(publicKeyFile)
@@ -88,10 +117,18 @@ func (s *AddrAuth) SetPublicKey(publicKeyFile string) error {
 	return nil
 }
 
-// Bind our AddrAuth as the gRPC api server.
+// Get the gRPC server options that install the AddrAuth interceptors.
+func (s *AddrAuth) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(s.StreamInterceptor),
+	}
+}
+
+// Bind the address book service as the gRPC api server, behind the AddrAuth interceptors.
 func (s *AddrAuth) NewApiServer(gServer *grpc.Server) error {
 	if s != nil {
-		pb.RegisterMServiceAddrbookServer(gServer, s)
+		pb.RegisterMServiceAddrbookServer(gServer, s.addrService)
 
 	}
 	return nil
@@ -165,451 +202,338 @@ func GetStringFromClaims(claims *map[string]interface{}, key string) string {
 	return val
 }
 
-// create new party
-func (s *AddrAuth) CreateParty(ctx context.Context, req *pb.CreatePartyRequest) (*pb.CreatePartyResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.CreatePartyResponse{}
-
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if addrsvc == "addradmin" {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.CreateParty(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
+// Get the user making the request from the claims: the JWT subject, or else the user id.
+func GetUserFromClaims(claims *map[string]interface{}) string {
+	user := GetStringFromClaims(claims, "sub")
+	if user == "" {
+		if uid := GetInt64FromClaims(claims, "uid"); uid != 0 {
+			user = strconv.FormatInt(uid, 10)
 		}
-
-		err = nil
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "CreateParty",
-		"lastname", req.GetLastName(),
-		"company", req.GetCompany(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
-
-	return resp, err
+	return user
 }
 
-// update an existing party
-func (s *AddrAuth) UpdateParty(ctx context.Context, req *pb.UpdatePartyRequest) (*pb.UpdatePartyResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.UpdatePartyResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.UpdateParty(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
-
-		err = nil
+// Helper to write the audit entry of a call, if an audit log is set; claims are nil if the JWT was
+// missing or invalid. Failures are logged but do not fail the call.
+func (s *AddrAuth) audit(claims *map[string]interface{}, rpc string, partyId int64, errorCode int32) {
+	if s.auditLog == nil {
+		return
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "UpdateParty",
-		"partyid", req.GetPartyId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	entry := &pb.AuditEntry{Rpc: rpc, PartyId: partyId, ErrorCode: errorCode}
+	if claims != nil {
+		entry.UserId = GetUserFromClaims(claims)
+		entry.MserviceId = GetInt64FromClaims(claims, "aid")
+		entry.Claim = GetStringFromClaims(claims, "addrsvc")
+	}
 
-	return resp, err
+	err := s.auditLog.WriteAuditEntry(context.Background(), entry)
+	if err != nil {
+		level.Error(s.logger).Log("what", "WriteAuditEntry", "error", err)
+	}
 }
 
-// delete an existing party
-func (s *AddrAuth) DeleteParty(ctx context.Context, req *pb.DeletePartyRequest) (*pb.DeletePartyResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.DeletePartyResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if addrsvc == "addradmin" {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.DeleteParty(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
-
-		err = nil
-	}
+// Authorization policy for an rpc method.
+type methodPolicy struct {
+	// claim levels allowed to call the method; none for a public method, which is not logged or audited
+	claims []string
+	// set the mservice_id of each request message to the aid claim
+	scoped bool
+}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "DeleteParty",
-		"partyid", req.GetPartyId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+var (
+	readPolicy   = methodPolicy{claims: []string{claimAdmin, claimReadWrite, claimReadOnly}, scoped: true}
+	writePolicy  = methodPolicy{claims: []string{claimAdmin, claimReadWrite}, scoped: true}
+	adminPolicy  = methodPolicy{claims: []string{claimAdmin}, scoped: true}
+	publicPolicy = methodPolicy{}
+)
 
-	return resp, err
+// Policies by rpc method name; methods not listed get adminPolicy.
+var methodPolicies = map[string]methodPolicy{
+	"create_party":               adminPolicy,
+	"update_party":               writePolicy,
+	"delete_party":               adminPolicy,
+	"get_party":                  readPolicy,
+	"get_parties":                readPolicy,
+	"get_party_wrapper":          readPolicy,
+	"create_address":             adminPolicy,
+	"update_address":             writePolicy,
+	"delete_address":             adminPolicy,
+	"get_address":                readPolicy,
+	"create_phone":               adminPolicy,
+	"update_phone":               writePolicy,
+	"delete_phone":               adminPolicy,
+	"get_phone":                  writePolicy,
+	"get_server_version":         publicPolicy,
+	"search_parties":             readPolicy,
+	"stream_party_wrappers":      readPolicy,
+	"export_vcard":               readPolicy,
+	"import_vcard":               adminPolicy,
+	"export_csv":                 readPolicy,
+	"import_csv":                 adminPolicy,
+	"bulk_upsert_party_wrappers": adminPolicy,
+	"create_party_wrapper":       adminPolicy,
+	"get_deleted_parties":        writePolicy,
+	"restore_party":              writePolicy,
+	"restore_address":            writePolicy,
+	"restore_phone":              writePolicy,
+	"purge_deleted":              adminPolicy,
+	"get_party_history":          readPolicy,
+	"query_audit_log":            adminPolicy,
+	"patch_party":                writePolicy,
+	"patch_address":              writePolicy,
+	"patch_phone":                writePolicy,
 }
 
-// get party by id
-func (s *AddrAuth) GetParty(ctx context.Context, req *pb.GetPartyRequest) (*pb.GetPartyResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.GetPartyResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") || (addrsvc == "addrro") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.GetParty(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
+// Helper to get the rpc method name and its policy from the full gRPC method name.
+func getMethodPolicy(fullMethod string) (string, methodPolicy) {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
 
-		err = nil
+	policy, ok := methodPolicies[method]
+	if !ok {
+		policy = adminPolicy
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetParty",
-		"partyid", req.GetPartyId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
-
-	return resp, err
+	return method, policy
 }
 
-// get parties by mservice id
-func (s *AddrAuth) GetParties(ctx context.Context, req *pb.GetPartiesRequest) (*pb.GetPartiesResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.GetPartiesResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
+// Helper to check the JWT in the context against a method policy. Returns the claims, nil if the JWT is
+// missing or invalid, and the error code and message if the call is denied.
+func (s *AddrAuth) authorize(ctx context.Context, policy methodPolicy) (*map[string]interface{}, int32, string) {
 	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") || (addrsvc == "addrro") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.GetParties(ctx, req)
-		}
-	} else {
+	if err != nil {
 		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
+			return nil, 498, tokenExpiredMessage
 		}
 
-		err = nil
+		return nil, 401, "not authorized"
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetParties",
-		"mservice", req.GetMserviceId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
-
-	return resp, err
-}
-
-// get party wrapper by id
-func (s *AddrAuth) GetPartyWrapper(ctx context.Context, req *pb.GetPartyWrapperRequest) (*pb.GetPartyWrapperResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.GetPartyWrapperResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") || (addrsvc == "addrro") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.GetPartyWrapper(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
+	addrsvc := GetStringFromClaims(claims, "addrsvc")
+	for _, claim := range policy.claims {
+		if addrsvc == claim {
+			return claims, 0, ""
 		}
-
-		err = nil
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetPartyWrapper",
-		"mservice", req.GetMserviceId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
-
-	return resp, err
+	return claims, 401, "not authorized"
 }
 
-// create a new address for a party
-func (s *AddrAuth) CreateAddress(ctx context.Context, req *pb.CreateAddressRequest) (*pb.CreateAddressResponse, error) {
+// Authorize, scope, log and audit a unary call.
+func (s *AddrAuth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	method, policy := getMethodPolicy(info.FullMethod)
+	if len(policy.claims) == 0 {
+		return handler(ctx, req)
+	}
+
 	start := time.Now().UnixNano()
-	resp := &pb.CreateAddressResponse{}
 
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
+	var resp interface{}
+	var err error
 
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if addrsvc == "addradmin" {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.CreateAddress(ctx, req)
+	claims, errorCode, errorMessage := s.authorize(ctx, policy)
+	if errorCode == 0 {
+		if policy.scoped {
+			setInt64Field(req, "mservice_id", GetInt64FromClaims(claims, "aid"))
 		}
+
+		ctx = addrservice.WithActingUser(ctx, GetUserFromClaims(claims))
+		resp, err = handler(ctx, req)
 	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
+		resp, err = errorResponse(method, errorCode, errorMessage)
+	}
 
-		err = nil
+	if err != nil {
+		level.Error(s.logger).Log("what", method, "error", err)
+		return resp, err
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "CreateAddress",
-		"partyid", req.GetPartyId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	// the target party is in the request, or in the response of a create
+	partyId := getInt64Field(req, "party_id")
+	if partyId == 0 {
+		partyId = getInt64Field(resp, "party_id")
+	}
 
-	return resp, err
-}
+	errorCode = getInt32Field(resp, "error_code")
 
-// update an existing address for a party
-func (s *AddrAuth) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRequest) (*pb.UpdateAddressResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.UpdateAddressResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", method,
+		"mservice", GetInt64FromClaims(claims, "aid"),
+		"partyid", partyId,
+		"errcode", errorCode, "duration", duration)
 
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.UpdateAddress(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
+	s.audit(claims, method, partyId, errorCode)
 
-		err = nil
+	if (errorCode != 0) && s.useStatusCodes(ctx) {
+		return nil, statusError(errorCode, getStringField(resp, "error_message"), claims != nil, resp)
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "UpdateAddress",
-		"partyid", req.GetPartyId(),
-		"addrtype", req.GetAddressType(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
-
-	return resp, err
+	return resp, nil
 }
 
-// delete an existing address for a party
-func (s *AddrAuth) DeleteAddress(ctx context.Context, req *pb.DeleteAddressRequest) (*pb.DeleteAddressResponse, error) {
+// Authorize, scope, log and audit a streaming call. A denied call gets a single response message with
+// the error code, or a status error if the call uses gRPC status codes; so does a call whose handler
+// sends a message with an error code.
+func (s *AddrAuth) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	method, policy := getMethodPolicy(info.FullMethod)
+	if len(policy.claims) == 0 {
+		return handler(srv, ss)
+	}
+
 	start := time.Now().UnixNano()
-	resp := &pb.DeleteAddressResponse{}
 
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
+	var err error
 
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if addrsvc == "addradmin" {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.DeleteAddress(ctx, req)
-		}
+	useStatusCodes := s.useStatusCodes(ss.Context())
+
+	claims, errorCode, errorMessage := s.authorize(ss.Context(), policy)
+	if errorCode == 0 {
+		stream := &authStream{ServerStream: ss,
+			ctx:        addrservice.WithActingUser(ss.Context(), GetUserFromClaims(claims)),
+			mserviceId: GetInt64FromClaims(claims, "aid"), scoped: policy.scoped, statusCodes: useStatusCodes}
+		err = handler(srv, stream)
+		errorCode = stream.errorCode
+	} else if useStatusCodes {
+		err = statusError(errorCode, errorMessage, claims != nil, nil)
 	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
+		var resp proto.Message
+		resp, err = errorResponse(method, errorCode, errorMessage)
+		if err == nil {
+			err = ss.SendMsg(resp)
 		}
+	}
 
-		err = nil
+	if (err != nil) && (errorCode == 0) {
+		level.Error(s.logger).Log("what", method, "error", err)
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "DeleteAddress",
-		"partyid", req.GetPartyId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	level.Info(s.logger).Log("endpoint", method,
+		"mservice", GetInt64FromClaims(claims, "aid"),
+		"errcode", errorCode, "duration", duration)
+
+	s.audit(claims, method, 0, errorCode)
 
-	return resp, err
+	return err
 }
 
-// get an address for a party by id
-func (s *AddrAuth) GetAddress(ctx context.Context, req *pb.GetAddressRequest) (*pb.GetAddressResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.GetAddressResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
+// Wraps a server stream to scope each message received to the mservice account of the JWT, carry
+// the user making the request in the context, and keep the error code of the last message sent.
+type authStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	mserviceId  int64
+	scoped      bool
+	statusCodes bool
+	errorCode   int32
+}
 
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") || (addrsvc == "addrro") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.GetAddress(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
+// Get the stream context, with the user making the request.
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
 
-		err = nil
+// Receive the next message, for the mservice account of the JWT.
+func (s *authStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if (err == nil) && s.scoped {
+		setInt64Field(m, "mservice_id", s.mserviceId)
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetAddress",
-		"mservice", req.GetMserviceId(),
-		"addrtype", req.GetAddressType(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
-
-	return resp, err
+	return err
 }
 
-// create a new  phone
-func (s *AddrAuth) CreatePhone(ctx context.Context, req *pb.CreatePhoneRequest) (*pb.CreatePhoneResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.CreatePhoneResponse{}
+// Send a message, keeping its error code; a message with an error code is returned as a status error
+// instead if the call uses gRPC status codes.
+func (s *authStream) SendMsg(m interface{}) error {
+	s.errorCode = getInt32Field(m, "error_code")
+	if (s.errorCode != 0) && s.statusCodes {
+		return statusError(s.errorCode, getStringField(m, "error_message"), true, m)
+	}
 
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
+	return s.ServerStream.SendMsg(m)
+}
 
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if addrsvc == "addradmin" {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.CreatePhone(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
+// Helper to get a new response message of an rpc method with the error code and message set.
+func errorResponse(method string, errorCode int32, errorMessage string) (proto.Message, error) {
+	md := pb.File_MServiceAddrbook_proto.Services().ByName("MServiceAddrbook").Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, NotImplemented
+	}
 
-		err = nil
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, err
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "CreatePhone",
-		"partyid", req.GetPartyId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	resp := mt.New()
+	if fd := resp.Descriptor().Fields().ByName("error_code"); fd != nil {
+		resp.Set(fd, protoreflect.ValueOfInt32(errorCode))
+	}
+	if fd := resp.Descriptor().Fields().ByName("error_message"); fd != nil {
+		resp.Set(fd, protoreflect.ValueOfString(errorMessage))
+	}
 
-	return resp, err
+	return resp.Interface(), nil
 }
 
-// update an existing phone
-func (s *AddrAuth) UpdatePhone(ctx context.Context, req *pb.UpdatePhoneRequest) (*pb.UpdatePhoneResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.UpdatePhoneResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.UpdatePhone(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
-
-		err = nil
+// Helper to get the named int64 field of a message, 0 if it has none.
+func getInt64Field(m interface{}, name protoreflect.Name) int64 {
+	msg, ok := m.(proto.Message)
+	if !ok || (msg == nil) {
+		return 0
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "UpdatePhone",
-		"partyid", req.GetPartyId(),
-		"phonetype", req.GetPhoneType(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(name)
+	if (fd == nil) || (fd.Kind() != protoreflect.Int64Kind) {
+		return 0
+	}
 
-	return resp, err
+	return msg.ProtoReflect().Get(fd).Int()
 }
 
-// delete an existing phone
-func (s *AddrAuth) DeletePhone(ctx context.Context, req *pb.DeletePhoneRequest) (*pb.DeletePhoneResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.DeletePhoneResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if addrsvc == "addradmin" {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.DeletePhone(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
-
-		err = nil
+// Helper to get the named int32 field of a message, 0 if it has none.
+func getInt32Field(m interface{}, name protoreflect.Name) int32 {
+	msg, ok := m.(proto.Message)
+	if !ok || (msg == nil) {
+		return 0
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "DeletePhone",
-		"partyid", req.GetPartyId(),
-		"phonetype", req.GetPhoneType(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(name)
+	if (fd == nil) || (fd.Kind() != protoreflect.Int32Kind) {
+		return 0
+	}
 
-	return resp, err
+	return int32(msg.ProtoReflect().Get(fd).Int())
 }
 
-// get a phone for a party by id
-func (s *AddrAuth) GetPhone(ctx context.Context, req *pb.GetPhoneRequest) (*pb.GetPhoneResponse, error) {
-	start := time.Now().UnixNano()
-	resp := &pb.GetPhoneResponse{}
-	resp.ErrorCode = 401
-	resp.ErrorMessage = "not authorized"
-
-	claims, err := s.GetJwtFromContext(ctx)
-	if err == nil {
-		addrsvc := GetStringFromClaims(claims, "addrsvc")
-		if (addrsvc == "addradmin") || (addrsvc == "addrrw") {
-			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.addrService.GetPhone(ctx, req)
-		}
-	} else {
-		if err.Error() == tokenExpiredMatch {
-			resp.ErrorCode = 498
-			resp.ErrorMessage = tokenExpiredMessage
-		}
-
-		err = nil
+// Helper to get the named string field of a message, empty if it has none.
+func getStringField(m interface{}, name protoreflect.Name) string {
+	msg, ok := m.(proto.Message)
+	if !ok || (msg == nil) {
+		return ""
 	}
 
-	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetPhone",
-		"partyid", req.GetPartyId(),
-		"phonetype", req.GetPhoneType(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(name)
+	if (fd == nil) || (fd.Kind() != protoreflect.StringKind) {
+		return ""
+	}
 
-	return resp, err
+	return msg.ProtoReflect().Get(fd).String()
 }
 
-// get current server version and uptime - health check
-func (s *AddrAuth) GetServerVersion(ctx context.Context, req *pb.GetServerVersionRequest) (*pb.GetServerVersionResponse, error) {
-	return s.addrService.GetServerVersion(ctx, req)
+// Helper to set the named int64 field of a message, if it has one.
+func setInt64Field(m interface{}, name protoreflect.Name, value int64) {
+	msg, ok := m.(proto.Message)
+	if !ok || (msg == nil) {
+		return
+	}
+
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(name)
+	if (fd != nil) && (fd.Kind() == protoreflect.Int64Kind) {
+		msg.ProtoReflect().Set(fd, protoreflect.ValueOfInt64(value))
+	}
 }
@@ -0,0 +1,370 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// mservice account in the test tokens
+const testMserviceId = 23
+
+// Key that signs the test tokens, made once as key generation is slow.
+var testKey struct {
+	once sync.Once
+	key  *rsa.PrivateKey
+}
+
+// Get an AddrAuth that accepts the test tokens.
+func newTestAuth(t *testing.T) *AddrAuth {
+	t.Helper()
+
+	testKey.once.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("GenerateKey: %v", err)
+		}
+		testKey.key = key
+	})
+
+	auth := NewAddrAuth(nil)
+	auth.SetLogger(log.NewNopLogger())
+	auth.rsaPSSPublicKey = &testKey.key.PublicKey
+
+	return auth
+}
+
+// Get an incoming call context with a test token for frodo at a claim level, in the test account; an
+// empty claim level gives a context without a token.
+func tokenContext(t *testing.T, claim string) context.Context {
+	t.Helper()
+
+	if claim == "" {
+		return metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	}
+
+	return signedContext(t, jwt.MapClaims{"sub": "frodo", "aid": testMserviceId, "addrsvc": claim})
+}
+
+// Get an incoming call context with a test token of the claims.
+func signedContext(t *testing.T, claims jwt.MapClaims) context.Context {
+	t.Helper()
+
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodPS256, claims).SignedString(testKey.key)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", tokenString))
+}
+
+// Get the full gRPC method name of an rpc method.
+func fullMethod(method string) string {
+	return "/org.gaterace.mservice.addrbook.MServiceAddrbook/" + method
+}
+
+func TestUnaryInterceptorAudit(t *testing.T) {
+	tests := []struct {
+		name        string
+		claim       string
+		statusCodes bool
+		mserviceId  int64
+		userId      string
+		errorCode   int32
+	}{
+		{"handler error code", claimReadWrite, false, testMserviceId, "frodo", 404},
+		{"status codes", claimReadWrite, true, testMserviceId, "frodo", 404},
+		{"no token", "", false, 0, "", 401},
+		{"claim too low", claimReadOnly, false, testMserviceId, "frodo", 401},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			auth := newTestAuth(t)
+			store := addrstore.NewMemoryStore()
+			auth.SetAuditLog(store)
+			auth.SetStatusCodes(test.statusCodes)
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &pb.UpdatePartyResponse{ErrorCode: 404, ErrorMessage: "not found"}, nil
+			}
+
+			resp, err := auth.UnaryInterceptor(tokenContext(t, test.claim), &pb.UpdatePartyRequest{PartyId: 7},
+				&grpc.UnaryServerInfo{FullMethod: fullMethod("update_party")}, handler)
+			if test.statusCodes {
+				if status.Convert(err).Message() != "not found" {
+					t.Fatalf("UnaryInterceptor: %v %v", resp, err)
+				}
+			} else if (err != nil) || (getInt32Field(resp, "error_code") != test.errorCode) {
+				t.Fatalf("UnaryInterceptor: %v %v", resp, err)
+			}
+
+			entries, _ := store.QueryAuditLog(context.Background(), &pb.QueryAuditLogRequest{MserviceId: test.mserviceId})
+			if len(entries) != 1 {
+				t.Fatalf("audit entries: %v", entries)
+			}
+
+			entry := entries[0]
+			if (entry.GetRpc() != "update_party") || (entry.GetUserId() != test.userId) || (entry.GetPartyId() != 7) ||
+				(entry.GetErrorCode() != test.errorCode) || (entry.GetClaim() != test.claim) {
+				t.Fatalf("audit entry: %v", entry)
+			}
+		})
+	}
+}
+
+func TestMethodPolicies(t *testing.T) {
+	// every rpc has a policy of its own, so none falls back to adminPolicy by accident
+	methods := pb.File_MServiceAddrbook_proto.Services().ByName("MServiceAddrbook").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		if _, ok := methodPolicies[string(methods.Get(i).Name())]; !ok {
+			t.Errorf("%s has no policy", methods.Get(i).Name())
+		}
+	}
+
+	tests := []struct {
+		method string
+		claim  string
+		called bool
+	}{
+		{"get_party", claimReadOnly, true},
+		{"get_party", claimReadWrite, true},
+		{"get_party", claimAdmin, true},
+		{"get_party", "", false},
+		{"update_party", claimReadOnly, false},
+		{"update_party", claimReadWrite, true},
+		{"update_party", claimAdmin, true},
+		{"create_party", claimReadWrite, false},
+		{"create_party", claimAdmin, true},
+		{"not_an_rpc", claimReadWrite, false},
+		{"not_an_rpc", claimAdmin, true},
+		{"get_server_version", "", true},
+		{"get_server_version", claimReadOnly, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.method+" "+test.claim, func(t *testing.T) {
+			auth := newTestAuth(t)
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return &pb.GetPartyResponse{}, nil
+			}
+
+			resp, err := auth.UnaryInterceptor(tokenContext(t, test.claim), &pb.GetPartyRequest{},
+				&grpc.UnaryServerInfo{FullMethod: fullMethod(test.method)}, handler)
+			if called != test.called {
+				t.Fatalf("handler called: %t, %v %v", called, resp, err)
+			}
+
+			if !test.called && (getInt32Field(resp, "error_code") != 401) && (err != NotImplemented) {
+				t.Fatalf("UnaryInterceptor: %v %v", resp, err)
+			}
+		})
+	}
+
+	if _, policy := getMethodPolicy(fullMethod("not_an_rpc")); !reflect.DeepEqual(policy, adminPolicy) {
+		t.Fatalf("policy of an unlisted method: %v", policy)
+	}
+
+	if _, policy := getMethodPolicy(fullMethod("get_server_version")); len(policy.claims) != 0 {
+		t.Fatalf("policy of get_server_version: %v", policy)
+	}
+}
+
+func TestUnaryInterceptorScope(t *testing.T) {
+	auth := newTestAuth(t)
+
+	var got *pb.GetPartyRequest
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = req.(*pb.GetPartyRequest)
+		return &pb.GetPartyResponse{}, nil
+	}
+
+	_, err := auth.UnaryInterceptor(tokenContext(t, claimReadOnly), &pb.GetPartyRequest{MserviceId: 99, PartyId: 7},
+		&grpc.UnaryServerInfo{FullMethod: fullMethod("get_party")}, handler)
+	if (err != nil) || (got.GetMserviceId() != testMserviceId) || (got.GetPartyId() != 7) {
+		t.Fatalf("request passed to the handler: %v %v", got, err)
+	}
+}
+
+// Server stream that receives copies of the given messages and keeps the messages sent on it.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv []proto.Message
+	sent []interface{}
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testServerStream) RecvMsg(m interface{}) error {
+	if len(s.recv) == 0 {
+		return io.EOF
+	}
+
+	proto.Merge(m.(proto.Message), s.recv[0])
+	s.recv = s.recv[1:]
+
+	return nil
+}
+
+func (s *testServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamInterceptorScope(t *testing.T) {
+	auth := newTestAuth(t)
+
+	ss := &testServerStream{ctx: tokenContext(t, claimAdmin)}
+	for i := 0; i < 3; i++ {
+		ss.recv = append(ss.recv, &pb.PartyWrapper{MserviceId: int64(99 + i), LastName: "Baggins"})
+	}
+
+	var received []*pb.PartyWrapper
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		for {
+			wrap := &pb.PartyWrapper{}
+			if err := stream.RecvMsg(wrap); err == io.EOF {
+				return stream.SendMsg(&pb.BulkUpsertPartyWrappersResponse{})
+			} else if err != nil {
+				return err
+			}
+
+			received = append(received, wrap)
+		}
+	}
+
+	err := auth.StreamInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: fullMethod("bulk_upsert_party_wrappers")},
+		handler)
+	if (err != nil) || (len(received) != 3) {
+		t.Fatalf("StreamInterceptor: %v, received %v", err, received)
+	}
+
+	for i, wrap := range received {
+		if (wrap.GetMserviceId() != testMserviceId) || (wrap.GetLastName() != "Baggins") {
+			t.Fatalf("message %d passed to the handler: %v", i, wrap)
+		}
+	}
+}
+
+func TestInterceptorErrorResponses(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          func(t *testing.T) context.Context
+		errorCode    int32
+		errorMessage string
+	}{
+		{"no token", func(t *testing.T) context.Context { return tokenContext(t, "") }, 401, "not authorized"},
+		{"expired token", func(t *testing.T) context.Context {
+			return signedContext(t, jwt.MapClaims{"sub": "frodo", "aid": testMserviceId, "addrsvc": claimAdmin,
+				"exp": time.Now().Add(-time.Hour).Unix()})
+		}, 498, tokenExpiredMessage},
+	}
+
+	methods := pb.File_MServiceAddrbook_proto.Services().ByName("MServiceAddrbook").Methods()
+
+	for _, test := range tests {
+		for i := 0; i < methods.Len(); i++ {
+			md := methods.Get(i)
+			method := string(md.Name())
+
+			if _, policy := getMethodPolicy(fullMethod(method)); len(policy.claims) == 0 {
+				continue
+			}
+
+			t.Run(test.name+" "+method, func(t *testing.T) {
+				auth := newTestAuth(t)
+				ctx := test.ctx(t)
+
+				var resp interface{}
+				var err error
+
+				if md.IsStreamingServer() || md.IsStreamingClient() {
+					ss := &testServerStream{ctx: ctx}
+					err = auth.StreamInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: fullMethod(method)},
+						func(srv interface{}, stream grpc.ServerStream) error {
+							t.Fatalf("handler called")
+							return nil
+						})
+					if len(ss.sent) != 1 {
+						t.Fatalf("StreamInterceptor sent %v: %v", ss.sent, err)
+					}
+					resp = ss.sent[0]
+				} else {
+					resp, err = auth.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod(method)},
+						func(ctx context.Context, req interface{}) (interface{}, error) {
+							t.Fatalf("handler called")
+							return nil, nil
+						})
+				}
+
+				msg, ok := resp.(proto.Message)
+				if (err != nil) || !ok || (msg.ProtoReflect().Descriptor().FullName() != md.Output().FullName()) {
+					t.Fatalf("response: %v %v", resp, err)
+				}
+
+				if (getInt32Field(msg, "error_code") != test.errorCode) ||
+					(getStringField(msg, "error_message") != test.errorMessage) {
+					t.Fatalf("response: %v", msg)
+				}
+			})
+		}
+	}
+}
+
+func TestUnaryInterceptorVersionConflictStatus(t *testing.T) {
+	auth := newTestAuth(t)
+	auth.SetStatusCodes(true)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.UpdatePhoneResponse{ErrorCode: 412, ErrorMessage: "version conflict", CurrentVersion: 4,
+			CurrentPhone: &pb.Phone{PartyId: 7, PhoneType: 3, Version: 4, PhoneNumber: "543-555-1111"}}, nil
+	}
+
+	_, err := auth.UnaryInterceptor(tokenContext(t, claimReadWrite), &pb.UpdatePhoneRequest{PartyId: 7},
+		&grpc.UnaryServerInfo{FullMethod: fullMethod("update_phone")}, handler)
+
+	st := status.Convert(err)
+	if (st.Code() != codes.FailedPrecondition) || (len(st.Details()) != 2) {
+		t.Fatalf("UnaryInterceptor: %v", err)
+	}
+
+	current, ok := st.Details()[1].(*pb.UpdatePhoneResponse)
+	if !ok || (current.GetCurrentPhone().GetPhoneNumber() != "543-555-1111") {
+		t.Fatalf("412 response detail: %v", st.Details()[1])
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Request metadata key and value asking for gRPC status codes instead of error_code values.
+const (
+	statusCodesKey   = "status-codes"
+	statusCodesValue = "grpc"
+)
+
+// Prefix of the error message listing the invalid fields of a 406 response.
+const invalidFieldsPrefix = "invalid fields: "
+
+// Prefix of the current version in the precondition failure of a 412 status error.
+const currentVersionPrefix = "current version "
+
+// gRPC status codes by addrbook error code.
+var statusCodes = map[int32]codes.Code{
+	401: codes.PermissionDenied,
+	404: codes.NotFound,
+	406: codes.InvalidArgument,
+	409: codes.AlreadyExists,
+	412: codes.FailedPrecondition,
+	498: codes.Unauthenticated,
+	500: codes.Internal,
+	501: codes.Internal,
+}
+
+// Check if a call gets gRPC status codes, because the server is set to use them or the request
+// metadata asks for them.
+func (s *AddrAuth) useStatusCodes(ctx context.Context) bool {
+	if s.statusCodes {
+		return true
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	values := md[statusCodesKey]
+
+	return (len(values) > 0) && (values[0] == statusCodesValue)
+}
+
+// Helper to convert a non-zero addrbook error code and message to a gRPC status error. A 401 is
+// Unauthenticated without a valid JWT and PermissionDenied with one; a 406 carries a
+// google.rpc.BadRequest with a field violation for each invalid field, and a 412 a
+// google.rpc.PreconditionFailure for the stale version. The violation description of a 412 response
+// with a current_version gives that version, as "current version N", and the response itself
+// follows as a second detail with the current record; resp is nil if there is no response.
+func statusError(errorCode int32, errorMessage string, authenticated bool, resp interface{}) error {
+	code, ok := statusCodes[errorCode]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	if (errorCode == 401) && !authenticated {
+		code = codes.Unauthenticated
+	}
+
+	st := status.New(code, errorMessage)
+
+	if (errorCode == 406) && strings.HasPrefix(errorMessage, invalidFieldsPrefix) {
+		badRequest := &errdetails.BadRequest{}
+		for _, field := range strings.Split(strings.TrimPrefix(errorMessage, invalidFieldsPrefix), ",") {
+			badRequest.FieldViolations = append(badRequest.FieldViolations,
+				&errdetails.BadRequest_FieldViolation{Field: field, Description: "invalid value"})
+		}
+
+		if detailed, err := st.WithDetails(badRequest); err == nil {
+			st = detailed
+		}
+	}
+
+	if errorCode == 412 {
+		violation := &errdetails.PreconditionFailure_Violation{Type: "VERSION", Subject: "version",
+			Description: errorMessage}
+
+		details := []protoadapt.MessageV1{&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{violation}}}
+
+		if currentVersion := getInt32Field(resp, "current_version"); currentVersion != 0 {
+			violation.Description = fmt.Sprintf("%s%d", currentVersionPrefix, currentVersion)
+
+			if msg, ok := resp.(protoadapt.MessageV1); ok {
+				details = append(details, msg)
+			}
+		}
+
+		if detailed, err := st.WithDetails(details...); err == nil {
+			st = detailed
+		}
+	}
+
+	return st.Err()
+}
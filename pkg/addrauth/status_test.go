@@ -0,0 +1,100 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrauth
+
+import (
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name          string
+		errorCode     int32
+		errorMessage  string
+		authenticated bool
+		code          codes.Code
+	}{
+		{"not found", 404, "not found", true, codes.NotFound},
+		{"invalid fields", 406, "invalid fields: last_name,email", true, codes.InvalidArgument},
+		{"version conflict", 412, "version conflict", true, codes.FailedPrecondition},
+		{"unauthenticated", 401, "invalid token", false, codes.Unauthenticated},
+		{"permission denied", 401, "permission denied", true, codes.PermissionDenied},
+		{"expired", 498, "token expired", true, codes.Unauthenticated},
+		{"write failed", 501, "database error", true, codes.Internal},
+		{"unknown code", 999, "surprise", true, codes.Unknown},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			st := status.Convert(statusError(test.errorCode, test.errorMessage, test.authenticated, nil))
+			if (st.Code() != test.code) || (st.Message() != test.errorMessage) {
+				t.Fatalf("statusError: %v %s", st.Code(), st.Message())
+			}
+		})
+	}
+}
+
+func TestStatusErrorDetails(t *testing.T) {
+	st := status.Convert(statusError(406, "invalid fields: last_name,email", true, nil))
+	if len(st.Details()) != 1 {
+		t.Fatalf("406 details: %v", st.Details())
+	}
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	if !ok || (len(badRequest.GetFieldViolations()) != 2) ||
+		(badRequest.GetFieldViolations()[0].GetField() != "last_name") ||
+		(badRequest.GetFieldViolations()[1].GetField() != "email") {
+		t.Fatalf("406 field violations: %v", st.Details()[0])
+	}
+
+	st = status.Convert(statusError(412, "version conflict", true, nil))
+	if len(st.Details()) != 1 {
+		t.Fatalf("412 details: %v", st.Details())
+	}
+
+	preconditionFailure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	if !ok || (len(preconditionFailure.GetViolations()) != 1) ||
+		(preconditionFailure.GetViolations()[0].GetSubject() != "version") {
+		t.Fatalf("412 violations: %v", st.Details()[0])
+	}
+
+	// the response of a version conflict gives the current version and record
+	resp := &pb.UpdatePartyResponse{ErrorCode: 412, ErrorMessage: "version conflict", CurrentVersion: 3,
+		CurrentParty: &pb.Party{PartyId: 7, Version: 3, LastName: "Baggins"}}
+	st = status.Convert(statusError(412, "version conflict", true, resp))
+	if (len(st.Details()) != 2) || (st.Message() != "version conflict") {
+		t.Fatalf("412 details with a response: %v", st.Details())
+	}
+
+	preconditionFailure, ok = st.Details()[0].(*errdetails.PreconditionFailure)
+	if !ok || (preconditionFailure.GetViolations()[0].GetDescription() != "current version 3") {
+		t.Fatalf("412 violations with a response: %v", st.Details()[0])
+	}
+
+	current, ok := st.Details()[1].(*pb.UpdatePartyResponse)
+	if !ok || (current.GetCurrentParty().GetLastName() != "Baggins") || (current.GetCurrentVersion() != 3) {
+		t.Fatalf("412 response detail: %v", st.Details()[1])
+	}
+
+	st = status.Convert(statusError(404, "not found", true, nil))
+	if len(st.Details()) != 0 {
+		t.Fatalf("404 details: %v", st.Details())
+	}
+}
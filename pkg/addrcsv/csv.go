@@ -0,0 +1,152 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The addrcsv package converts MServiceAddrbook party wrappers to and from CSV rows, with
+// one column per party field, per home_ and shipping_ address field, and per phone type.
+
+package addrcsv
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// MIME type of encoded CSV data.
+const ContentType = "text/csv"
+
+// Party columns.
+var partyColumns = []string{"party_id", "party_type", "first_name", "middle_name", "last_name", "nickname",
+	"company", "email"}
+
+// Address field columns, prefixed by home_ or shipping_.
+var addressColumns = []string{"address_1", "address_2", "city", "state", "postal_code", "country_code"}
+
+// Address column prefixes, by address type.
+var addressPrefixes = map[int32]string{
+	int32(pb.AddressType_Home):     "home_",
+	int32(pb.AddressType_Shipping): "shipping_",
+}
+
+// Phone columns, by phone type.
+var phoneColumns = map[int32]string{
+	int32(pb.PhoneType_HomePhone): "home_phone",
+	int32(pb.PhoneType_WorkPhone): "work_phone",
+	int32(pb.PhoneType_CellPhone): "cell_phone",
+}
+
+// Party type column values, by party type.
+var partyTypeNames = map[int32]string{
+	int32(pb.PartyType_Person):   "person",
+	int32(pb.PartyType_Business): "business",
+}
+
+// Get the names of all columns, in export order.
+func Columns() []string {
+	columns := append([]string{}, partyColumns...)
+
+	for _, addrType := range []int32{int32(pb.AddressType_Home), int32(pb.AddressType_Shipping)} {
+		for _, column := range addressColumns {
+			columns = append(columns, addressPrefixes[addrType]+column)
+		}
+	}
+
+	for _, phoneType := range []int32{int32(pb.PhoneType_HomePhone), int32(pb.PhoneType_WorkPhone), int32(pb.PhoneType_CellPhone)} {
+		columns = append(columns, phoneColumns[phoneType])
+	}
+
+	return columns
+}
+
+// Writes party wrappers as CSV rows, after a header row.
+type Writer struct {
+	w       *csv.Writer
+	columns []string
+	header  bool
+}
+
+// Get a new Writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: csv.NewWriter(w), columns: Columns()}
+}
+
+// Leave out the header row, as for a later page of rows.
+func (cw *Writer) OmitHeader() {
+	cw.header = true
+}
+
+// Write a party wrapper as one row, writing the header row first if needed.
+func (cw *Writer) Write(wrap *pb.PartyWrapper) error {
+	if !cw.header {
+		err := cw.w.Write(cw.columns)
+		if err != nil {
+			return err
+		}
+
+		cw.header = true
+	}
+
+	values := make(map[string]string)
+	values["party_id"] = strconv.FormatInt(wrap.GetPartyId(), 10)
+	values["party_type"] = partyTypeNames[wrap.GetPartyType()]
+	values["first_name"] = wrap.GetFirstName()
+	values["middle_name"] = wrap.GetMiddleName()
+	values["last_name"] = wrap.GetLastName()
+	values["nickname"] = wrap.GetNickname()
+	values["company"] = wrap.GetCompany()
+	values["email"] = wrap.GetEmail()
+
+	for _, addr := range wrap.GetAddresses() {
+		prefix, ok := addressPrefixes[addr.GetAddressType()]
+		if !ok {
+			continue
+		}
+
+		values[prefix+"address_1"] = addr.GetAddress_1()
+		values[prefix+"address_2"] = addr.GetAddress_2()
+		values[prefix+"city"] = addr.GetCity()
+		values[prefix+"state"] = addr.GetState()
+		values[prefix+"postal_code"] = addr.GetPostalCode()
+		values[prefix+"country_code"] = addr.GetCountryCode()
+	}
+
+	for _, phone := range wrap.GetPhones() {
+		if column, ok := phoneColumns[phone.GetPhoneType()]; ok {
+			values[column] = phone.GetPhoneNumber()
+		}
+	}
+
+	record := make([]string, len(cw.columns))
+	for i, column := range cw.columns {
+		record[i] = values[column]
+	}
+
+	return cw.w.Write(record)
+}
+
+// Flush buffered rows, writing the header row if no rows were written.
+func (cw *Writer) Flush() error {
+	if !cw.header {
+		err := cw.w.Write(cw.columns)
+		if err != nil {
+			return err
+		}
+
+		cw.header = true
+	}
+
+	cw.w.Flush()
+	return cw.w.Error()
+}
@@ -0,0 +1,85 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrcsv
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+const header = "party_id,party_type,first_name,middle_name,last_name,nickname,company,email," +
+	"home_address_1,home_address_2,home_city,home_state,home_postal_code,home_country_code," +
+	"shipping_address_1,shipping_address_2,shipping_city,shipping_state,shipping_postal_code,shipping_country_code," +
+	"home_phone,work_phone,cell_phone\n"
+
+var frodo = &pb.PartyWrapper{
+	PartyId:   7,
+	PartyType: int32(pb.PartyType_Person),
+	FirstName: "Frodo",
+	LastName:  "Baggins",
+	Email:     "frodo@baggins.org",
+	Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Shipping), Address_1: "1 Bag End, Bagshot Row",
+		City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"}},
+	Phones: []*pb.Phone{{PhoneType: int32(pb.PhoneType_CellPhone), PhoneNumber: "543-555-1111"}},
+}
+
+var frodoRow = "7,person,Frodo,,Baggins,,,frodo@baggins.org,,,,,,," +
+	"\"1 Bag End, Bagshot Row\",,Hobbiton,WA,98000,us,,,543-555-1111\n"
+
+func TestWriter(t *testing.T) {
+	tests := []struct {
+		name       string
+		wraps      []*pb.PartyWrapper
+		omitHeader bool
+		want       string
+	}{
+		{"rows", []*pb.PartyWrapper{frodo}, false, header + frodoRow},
+		{"no rows", nil, false, header},
+		{"later page", []*pb.PartyWrapper{frodo}, true, frodoRow},
+		{"empty later page", nil, true, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer := NewWriter(&buf)
+			if test.omitHeader {
+				writer.OmitHeader()
+			}
+
+			for _, wrap := range test.wraps {
+				if err := writer.Write(wrap); err != nil {
+					t.Fatalf("Write: %v", err)
+				}
+			}
+
+			if err := writer.Flush(); err != nil {
+				t.Fatalf("Flush: %v", err)
+			}
+
+			if buf.String() != test.want {
+				t.Fatalf("Writer:\n%s\nwant:\n%s", buf.String(), test.want)
+			}
+		})
+	}
+}
+
+func TestColumns(t *testing.T) {
+	if got := strings.Join(Columns(), ",") + "\n"; got != header {
+		t.Fatalf("Columns: %s", got)
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrcsv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Separator Google Contacts uses for multiple values in one cell.
+const multiValueSeparator = " ::: "
+
+// Maps a column of the input to an addrcsv column.
+//
+// When the type of an address or phone is given by another column, as in Google Contacts
+// exports, Target is the unprefixed address column (address_1 ... country_code) or phone,
+// and Label is the column holding the type; all columns with the same Label make up one
+// address or phone.
+type ColumnMap struct {
+	Source string
+	Target string
+	Label  string
+}
+
+// A party wrapper read from one row, without identifiers; an unknown party_type leaves
+// the party type 0.
+type Row struct {
+	// data row number, starting at 1 after the header row
+	Number int
+	Wrap   *pb.PartyWrapper
+}
+
+// An address or phone whose type is given by a label column.
+type labelled struct {
+	label  string
+	values map[string]string
+}
+
+// Column positions of a mapping.
+type boundMap struct {
+	source int
+	target string
+	label  int
+}
+
+// Read all rows, mapping columns with mapping, or by column name if mapping is empty.
+//
+// Addresses are typed home or shipping (shipping, parcel or postal), and phones home, work
+// or cell (mobile); untyped entries take the first free type. Later entries for a type
+// already taken are dropped.
+func Decode(r io.Reader, mapping []ColumnMap) ([]*Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	bound, err := bindMapping(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*Row
+
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := convertRecord(record, bound)
+		if row != nil {
+			row.Number = number
+			rows = append(rows, row)
+		}
+	}
+
+	return rows, nil
+}
+
+// Check that every mapping target is an addrcsv column.
+func ValidateMapping(mapping []ColumnMap) error {
+	targets := make(map[string]bool)
+	for _, column := range Columns() {
+		targets[column] = true
+	}
+
+	labelTargets := map[string]bool{"phone": true}
+	for _, column := range addressColumns {
+		labelTargets[column] = true
+	}
+
+	for _, m := range mapping {
+		if (m.Label == "") && !targets[m.Target] {
+			return fmt.Errorf("unknown target column: %s", m.Target)
+		}
+
+		if (m.Label != "") && !labelTargets[m.Target] {
+			return fmt.Errorf("unknown labelled target column: %s", m.Target)
+		}
+	}
+
+	return nil
+}
+
+// Helper to check a mapping and find the positions of its columns in the header.
+func bindMapping(header []string, mapping []ColumnMap) ([]*boundMap, error) {
+	if len(mapping) == 0 {
+		for _, column := range Columns() {
+			mapping = append(mapping, ColumnMap{Source: column, Target: column})
+		}
+	}
+
+	err := ValidateMapping(mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	var bound []*boundMap
+
+	for _, m := range mapping {
+		source := findColumn(header, m.Source)
+		if source < 0 {
+			continue
+		}
+
+		label := -1
+		if m.Label != "" {
+			label = findColumn(header, m.Label)
+			if label < 0 {
+				continue
+			}
+		}
+
+		bound = append(bound, &boundMap{source: source, target: m.Target, label: label})
+	}
+
+	return bound, nil
+}
+
+// Helper to find a column by name, exactly or else ignoring case, or -1 if missing.
+func findColumn(header []string, name string) int {
+	for i, column := range header {
+		if column == name {
+			return i
+		}
+	}
+
+	for i, column := range header {
+		if strings.EqualFold(strings.TrimSpace(column), strings.TrimSpace(name)) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Helper to get a cell, trimmed to its first value, or empty if missing.
+func cell(record []string, i int) string {
+	if (i < 0) || (i >= len(record)) {
+		return ""
+	}
+
+	value := record[i]
+	if pos := strings.Index(value, multiValueSeparator); pos >= 0 {
+		value = value[:pos]
+	}
+
+	return strings.TrimSpace(value)
+}
+
+// Helper to convert one record to a row, or nil if the mapped cells are all empty.
+func convertRecord(record []string, bound []*boundMap) *Row {
+	values := make(map[string]string)
+	addrGroups := make(map[int]*labelled)
+	phoneGroups := make(map[int]*labelled)
+	var groupOrder []int
+
+	empty := true
+
+	for _, b := range bound {
+		value := cell(record, b.source)
+		if value == "" {
+			continue
+		}
+
+		empty = false
+
+		if b.label < 0 {
+			// the first non-empty source wins
+			if values[b.target] == "" {
+				values[b.target] = value
+			}
+			continue
+		}
+
+		groups := addrGroups
+		if b.target == "phone" {
+			groups = phoneGroups
+		}
+
+		group, ok := groups[b.label]
+		if !ok {
+			group = &labelled{label: normalizeLabel(cell(record, b.label)), values: make(map[string]string)}
+			groups[b.label] = group
+			groupOrder = append(groupOrder, b.label)
+		}
+
+		group.values[b.target] = value
+	}
+
+	if empty {
+		return nil
+	}
+
+	row := &Row{Wrap: &pb.PartyWrapper{}}
+	wrap := row.Wrap
+
+	wrap.FirstName = values["first_name"]
+	wrap.MiddleName = values["middle_name"]
+	wrap.LastName = values["last_name"]
+	wrap.Nickname = values["nickname"]
+	wrap.Company = values["company"]
+	wrap.Email = values["email"]
+
+	switch strings.ToLower(values["party_type"]) {
+	case "person", "1":
+		wrap.PartyType = int32(pb.PartyType_Person)
+	case "business", "2":
+		wrap.PartyType = int32(pb.PartyType_Business)
+	case "":
+		if (wrap.LastName == "") && (wrap.FirstName == "") && (wrap.Company != "") {
+			wrap.PartyType = int32(pb.PartyType_Business)
+		} else {
+			wrap.PartyType = int32(pb.PartyType_Person)
+		}
+	}
+
+	addrTaken := make(map[int32]bool)
+	phoneTaken := make(map[int32]bool)
+
+	for _, addrType := range []int32{int32(pb.AddressType_Home), int32(pb.AddressType_Shipping)} {
+		fields := make(map[string]string)
+		for _, column := range addressColumns {
+			if value := values[addressPrefixes[addrType]+column]; value != "" {
+				fields[column] = value
+			}
+		}
+
+		if len(fields) > 0 {
+			addrTaken[addrType] = true
+			wrap.Addresses = append(wrap.Addresses, convertAddress(addrType, fields))
+		}
+	}
+
+	for _, phoneType := range []int32{int32(pb.PhoneType_HomePhone), int32(pb.PhoneType_WorkPhone), int32(pb.PhoneType_CellPhone)} {
+		if value := values[phoneColumns[phoneType]]; value != "" {
+			phoneTaken[phoneType] = true
+			wrap.Phones = append(wrap.Phones, &pb.Phone{PhoneType: phoneType, PhoneNumber: value})
+		}
+	}
+
+	for _, key := range groupOrder {
+		if group, ok := addrGroups[key]; ok {
+			addrType := freeType(addressLabelType(group.label),
+				[]int32{int32(pb.AddressType_Home), int32(pb.AddressType_Shipping)}, addrTaken)
+			if addrType != 0 {
+				wrap.Addresses = append(wrap.Addresses, convertAddress(addrType, group.values))
+			}
+		}
+
+		if group, ok := phoneGroups[key]; ok {
+			phoneType := freeType(phoneLabelType(group.label),
+				[]int32{int32(pb.PhoneType_HomePhone), int32(pb.PhoneType_WorkPhone), int32(pb.PhoneType_CellPhone)},
+				phoneTaken)
+			if phoneType != 0 {
+				wrap.Phones = append(wrap.Phones, &pb.Phone{PhoneType: phoneType, PhoneNumber: group.values["phone"]})
+			}
+		}
+	}
+
+	return row
+}
+
+// Helper to build an address from unprefixed address column values.
+func convertAddress(addrType int32, fields map[string]string) *pb.Address {
+	addr := &pb.Address{}
+	addr.AddressType = addrType
+	addr.Address_1 = fields["address_1"]
+	addr.Address_2 = fields["address_2"]
+	addr.City = fields["city"]
+	addr.State = fields["state"]
+	addr.PostalCode = fields["postal_code"]
+	addr.CountryCode = fields["country_code"]
+
+	return addr
+}
+
+// Helper to lower case a label, dropping the "* " Google Contacts uses for the primary value.
+func normalizeLabel(label string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(label, "* ")))
+}
+
+// Helper to get the address type for a label, or 0 if untyped.
+func addressLabelType(label string) int32 {
+	switch label {
+	case "home":
+		return int32(pb.AddressType_Home)
+	case "shipping", "parcel", "postal":
+		return int32(pb.AddressType_Shipping)
+	}
+
+	return 0
+}
+
+// Helper to get the phone type for a label, or 0 if untyped.
+func phoneLabelType(label string) int32 {
+	switch label {
+	case "home":
+		return int32(pb.PhoneType_HomePhone)
+	case "work", "business":
+		return int32(pb.PhoneType_WorkPhone)
+	case "mobile", "cell":
+		return int32(pb.PhoneType_CellPhone)
+	}
+
+	return 0
+}
+
+// Helper to claim a type, or the first free type if untyped, returning 0 if none is free.
+func freeType(wanted int32, order []int32, taken map[int32]bool) int32 {
+	if wanted != 0 {
+		if taken[wanted] {
+			return 0
+		}
+
+		taken[wanted] = true
+		return wanted
+	}
+
+	for _, t := range order {
+		if !taken[t] {
+			taken[t] = true
+			return t
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,111 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrcsv
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Part of the Google Contacts mapping in cmd/addrclient/google_contacts.map.
+var googleMapping = []ColumnMap{
+	{Source: "First Name", Target: "first_name"},
+	{Source: "Last Name", Target: "last_name"},
+	{Source: "Organization Name", Target: "company"},
+	{Source: "E-mail 1 - Value", Target: "email"},
+	{Source: "Address 1 - Street", Target: "address_1", Label: "Address 1 - Label"},
+	{Source: "Address 1 - City", Target: "city", Label: "Address 1 - Label"},
+	{Source: "Phone 1 - Value", Target: "phone", Label: "Phone 1 - Label"},
+	{Source: "Phone 2 - Value", Target: "phone", Label: "Phone 2 - Label"},
+	{Source: "Phone 3 - Value", Target: "phone", Label: "Phone 3 - Label"},
+}
+
+const googleHeader = "First Name,Last Name,Organization Name,E-mail 1 - Value,Address 1 - Label,Address 1 - Street," +
+	"Address 1 - City,Phone 1 - Label,Phone 1 - Value,Phone 2 - Label,Phone 2 - Value,Phone 3 - Label,Phone 3 - Value\n"
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		mapping []ColumnMap
+		want    []*Row
+	}{
+		{"export round trip", header + frodoRow, nil, []*Row{{Number: 1, Wrap: &pb.PartyWrapper{
+			PartyType: int32(pb.PartyType_Person), FirstName: "Frodo", LastName: "Baggins", Email: "frodo@baggins.org",
+			Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Shipping), Address_1: "1 Bag End, Bagshot Row",
+				City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"}},
+			Phones: []*pb.Phone{{PhoneType: int32(pb.PhoneType_CellPhone), PhoneNumber: "543-555-1111"}}}}}},
+		{"byte order mark, case and blank rows", "\ufeffFIRST_NAME,Last_Name,company\n,,\nSam,Gamgee,\n,,Green Dragon\n",
+			nil, []*Row{
+				{Number: 2, Wrap: &pb.PartyWrapper{PartyType: int32(pb.PartyType_Person), FirstName: "Sam",
+					LastName: "Gamgee"}},
+				{Number: 3, Wrap: &pb.PartyWrapper{PartyType: int32(pb.PartyType_Business), Company: "Green Dragon"}},
+			}},
+		{"google labels", googleHeader +
+			"Merry,Brandybuck,,merry@buckland.org ::: meriadoc@buckland.org,* Home,Brandy Hall,Buckland," +
+			"Mobile,543-555-2222,Other,543-555-3333,* Mobile,543-555-4444\n",
+			googleMapping, []*Row{{Number: 1, Wrap: &pb.PartyWrapper{
+				PartyType: int32(pb.PartyType_Person), FirstName: "Merry", LastName: "Brandybuck",
+				Email: "merry@buckland.org",
+				Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Home), Address_1: "Brandy Hall",
+					City: "Buckland"}},
+				Phones: []*pb.Phone{
+					{PhoneType: int32(pb.PhoneType_CellPhone), PhoneNumber: "543-555-2222"},
+					{PhoneType: int32(pb.PhoneType_HomePhone), PhoneNumber: "543-555-3333"},
+				}}}}},
+		{"unknown party type", "party_type,last_name\nwizard,Gandalf\n", nil, []*Row{{Number: 1,
+			Wrap: &pb.PartyWrapper{LastName: "Gandalf"}}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rows, err := Decode(strings.NewReader(test.data), test.mapping)
+			if (err != nil) || (len(rows) != len(test.want)) {
+				t.Fatalf("Decode: %v %v", rows, err)
+			}
+
+			for i, row := range rows {
+				if (row.Number != test.want[i].Number) || !proto.Equal(row.Wrap, test.want[i].Wrap) {
+					t.Fatalf("Decode row %d: %d %v\nwant: %d %v", i, row.Number, row.Wrap, test.want[i].Number,
+						test.want[i].Wrap)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping []ColumnMap
+		valid   bool
+	}{
+		{"columns", []ColumnMap{{Source: "Surname", Target: "last_name"}, {Source: "Mobile", Target: "cell_phone"}}, true},
+		{"labelled", []ColumnMap{{Source: "Street", Target: "address_1", Label: "Kind"}}, true},
+		{"unknown column", []ColumnMap{{Source: "Birthday", Target: "birthday"}}, false},
+		{"labelled prefixed column", []ColumnMap{{Source: "Street", Target: "home_address_1", Label: "Kind"}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateMapping(test.mapping); (err == nil) != test.valid {
+				t.Fatalf("ValidateMapping: %v", err)
+			}
+		})
+	}
+}
@@ -23,15 +23,14 @@ import (
 	"strings"
 	"time"
 
-	"github.com/gaterace/dml-go/pkg/dml"
 	"github.com/go-kit/kit/log/level"
 
 	"github.com/go-kit/kit/log"
 
-	_ "github.com/go-sql-driver/mysql"
-
+	"github.com/gaterace/addrbook/pkg/addrstore"
 	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var NotImplemented = errors.New("not implemented")
@@ -58,8 +57,16 @@ var phoneTypeMap = map[int32]string{
 type addrService struct {
 	pb.UnimplementedMServiceAddrbookServer
 	logger    log.Logger
-	db        *sql.DB
+	store     addrstore.Store
 	startSecs int64
+	// retention window for deleted records, 0 to keep them
+	purgeAfterDays int
+	// maximum rows removed from each table per store call
+	purgeBatchSize int
+	// how long create responses are kept for idempotency keys, 0 to ignore keys
+	idempotencyWindow time.Duration
+	// idempotency windows for particular mservice accounts
+	accountIdempotencyWindows map[int64]time.Duration
 }
 
 // Get a new addrService instance.
@@ -74,9 +81,28 @@ func (s *addrService) SetLogger(logger log.Logger) {
 	s.logger = logger
 }
 
-// Set the database connection for the addrService instance.
+// Set the storage backend for the addrService instance.
+func (s *addrService) SetStore(store addrstore.Store) {
+	s.store = store
+}
+
+// Set the retention window in days for deleted records, and the number of rows purged from each
+// table per batch.
+func (s *addrService) SetPurgePolicy(purgeAfterDays int, purgeBatchSize int) {
+	s.purgeAfterDays = purgeAfterDays
+	s.purgeBatchSize = purgeBatchSize
+}
+
+// Set how long the responses of creates made with an idempotency key are kept for replay, by default
+// and for particular mservice accounts; a window of 0 turns idempotency keys off.
+func (s *addrService) SetIdempotencyWindows(window time.Duration, accountWindows map[int64]time.Duration) {
+	s.idempotencyWindow = window
+	s.accountIdempotencyWindows = accountWindows
+}
+
+// Set a MySQL database connection as the storage backend for the addrService instance.
 func (s *addrService) SetDatabaseConnection(sqlDB *sql.DB) {
-	s.db = sqlDB
+	s.store = addrstore.NewMysqlStore(sqlDB)
 }
 
 // Bind this addrService the gRPC server api.
@@ -92,40 +118,22 @@ func (s *addrService) NewApiServer(gServer *grpc.Server) error {
 func (s *addrService) CreateParty(ctx context.Context, req *pb.CreatePartyRequest) (*pb.CreatePartyResponse, error) {
 	resp := &pb.CreatePartyResponse{}
 
-	// validate all inputs
-
-	var invalidFields []string
-
-	if _, ok := partyTypeMap[req.GetPartyType()]; !ok {
-		invalidFields = append(invalidFields, "party_type")
-	}
-
-	if !isValidName(req.GetLastName()) {
-		invalidFields = append(invalidFields, "last_name")
-	}
-
-	if !isValidName(req.GetFirstName()) {
-		invalidFields = append(invalidFields, "first_name")
-	}
-
-	if (req.GetMiddleName() != "") && !isValidName(req.GetMiddleName()) {
-		invalidFields = append(invalidFields, "middle_name")
-	}
-
-	if (req.GetNickname() != "") && !isValidName(req.GetNickname()) {
-		invalidFields = append(invalidFields, "nickname")
-	}
-
-	if (req.GetCompany() != "") && !isValidCompany(req.GetCompany()) {
-		invalidFields = append(invalidFields, "company")
-	}
+	party := pb.Party{}
+	party.MserviceId = req.GetMserviceId()
+	party.PartyType = req.GetPartyType()
+	party.LastName = req.GetLastName()
+	party.MiddleName = req.GetMiddleName()
+	party.FirstName = req.GetFirstName()
+	party.Nickname = req.GetNickname()
+	party.Company = req.GetCompany()
+	party.Email = req.GetEmail()
 
-	if (req.GetCompany() == "") && (req.GetPartyType() == 2) {
-		invalidFields = append(invalidFields, "company")
-	}
+	// validate all inputs
+	invalidFields := validateParty(&party)
 
-	if !isValidEmail(req.GetEmail()) {
-		invalidFields = append(invalidFields, "email")
+	key, ok := s.idempotencyKey(ctx, party.MserviceId)
+	if !ok {
+		invalidFields = append(invalidFields, "idempotency_key")
 	}
 
 	if len(invalidFields) > 0 {
@@ -134,38 +142,37 @@ func (s *addrService) CreateParty(ctx context.Context, req *pb.CreatePartyReques
 		return resp, nil
 	}
 
-	sqlstring := `INSERT INTO tb_Party
-      (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, intPartyType, chvLastName,
-      chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail) 
-      VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, ?)`
+	var partyId int64
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+	replayed, err := s.createOnce(ctx, party.MserviceId, key, "create_party", req, resp,
+		func(tx addrstore.Store) (proto.Message, error) {
+			var err error
+			partyId, err = tx.CreateParty(ctx, &party)
+			if err != nil {
+				return nil, err
+			}
 
-	defer stmt.Close()
+			err = recordHistory(ctx, tx, party.MserviceId, partyId)
+			return &pb.CreatePartyResponse{PartyId: partyId, Version: 1}, err
+		})
 
-	res, err := stmt.Exec(req.GetMserviceId(), req.GetPartyType(), req.GetLastName(), req.GetMiddleName(),
-		req.GetFirstName(), req.GetNickname(), req.GetCompany(), req.GetEmail())
+	if replayed {
+		return resp, nil
+	}
 
 	if err == nil {
-		partyId, err := res.LastInsertId()
-		if err != nil {
-			level.Error(s.logger).Log("what", "LastInsertId", "error", err)
-		} else {
-			level.Debug(s.logger).Log("partyId", partyId)
-		}
+		level.Debug(s.logger).Log("partyId", partyId)
 
 		resp.PartyId = partyId
 		resp.Version = 1
+	} else if err == errKeyReused {
+		resp.ErrorCode = 409
+		resp.ErrorMessage = err.Error()
+		err = nil
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
+		level.Error(s.logger).Log("what", "CreateParty", "error", err)
 		err = nil
 	}
 
@@ -176,101 +183,170 @@ func (s *addrService) CreateParty(ctx context.Context, req *pb.CreatePartyReques
 func (s *addrService) UpdateParty(ctx context.Context, req *pb.UpdatePartyRequest) (*pb.UpdatePartyResponse, error) {
 	resp := &pb.UpdatePartyResponse{}
 
+	party := pb.Party{}
+	party.MserviceId = req.GetMserviceId()
+	party.PartyId = req.GetPartyId()
+	party.Version = req.GetVersion()
+	party.PartyType = req.GetPartyType()
+	party.LastName = req.GetLastName()
+	party.MiddleName = req.GetMiddleName()
+	party.FirstName = req.GetFirstName()
+	party.Nickname = req.GetNickname()
+	party.Company = req.GetCompany()
+	party.Email = req.GetEmail()
+
 	// validate all inputs
-	inputValid := true
+	invalidFields := validateParty(&party)
 
-	if !isValidName(req.GetLastName()) {
-		inputValid = false
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return resp, nil
 	}
 
-	if !isValidName(req.GetFirstName()) {
-		inputValid = false
-	}
+	var version int32
 
-	if (req.GetMiddleName() != "") && !isValidName(req.GetMiddleName()) {
-		inputValid = false
-	}
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.UpdateParty(ctx, &party)
+		if err != nil {
+			return err
+		}
 
-	if (req.GetNickname() != "") && !isValidName(req.GetNickname()) {
-		inputValid = false
-	}
+		return recordHistory(ctx, tx, party.MserviceId, party.PartyId)
+	})
 
-	if (req.GetCompany() != "") && !isValidCompany(req.GetCompany()) {
-		inputValid = false
+	if err == nil {
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingParty(ctx, party.MserviceId, party.PartyId, party.Version); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentParty = current
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+		err = nil
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "UpdateParty", "error", err)
+		err = nil
 	}
 
-	if (req.GetCompany() == "") && (req.GetPartyType() == 2) {
-		inputValid = false
-	}
+	return resp, err
+}
 
-	if !isValidEmail(req.GetEmail()) {
-		inputValid = false
-	}
+// delete an existing party
+func (s *addrService) DeleteParty(ctx context.Context, req *pb.DeletePartyRequest) (*pb.DeletePartyResponse, error) {
+	resp := &pb.DeletePartyResponse{}
 
-	if !inputValid {
-		resp.ErrorCode = 406
-		resp.ErrorMessage = "one or more request fields are invalid"
-		return resp, nil
-	}
+	var version int32
 
-	sqlstring := `UPDATE tb_Party SET dtmModified = NOW(), intVersion = ?, intPartyType = ?, chvLastName = ?,
-    chvMiddleName = ?, chvFirstName = ?, chvNickname = ?, chvCompany = ?, chvEmail= ? 
-    WHERE inbMserviceId = ? AND inbPartyId = ? AND intVersion = ? AND  bitIsDeleted= 0`
+	// soft delete the addresses and phones with the party
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.DeleteParty(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetVersion())
+		if err != nil {
+			return err
+		}
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+		err = tx.DeleteAddresses(ctx, req.GetMserviceId(), req.GetPartyId())
+		if err != nil {
+			return err
+		}
 
-	defer stmt.Close()
+		err = tx.DeletePhones(ctx, req.GetMserviceId(), req.GetPartyId())
+		if err != nil {
+			return err
+		}
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetPartyType(), req.GetLastName(), req.GetMiddleName(),
-		req.GetFirstName(), req.GetNickname(), req.GetCompany(), req.GetEmail(), req.GetMserviceId(), req.GetPartyId(),
-		req.GetVersion())
+		return recordHistory(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingParty(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetVersion()); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentParty = current
 		} else {
 			resp.ErrorCode = 404
 			resp.ErrorMessage = "not found"
 		}
+		err = nil
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
+		level.Error(s.logger).Log("what", "DeleteParty", "error", err)
 		err = nil
 	}
 
 	return resp, err
 }
 
-// delete an existing party
-func (s *addrService) DeleteParty(ctx context.Context, req *pb.DeletePartyRequest) (*pb.DeletePartyResponse, error) {
-	resp := &pb.DeletePartyResponse{}
+// get deleted parties by mservice id, most recently deleted first
+func (s *addrService) GetDeletedParties(ctx context.Context, req *pb.GetDeletedPartiesRequest) (*pb.GetDeletedPartiesResponse, error) {
+	resp := &pb.GetDeletedPartiesResponse{}
 
-	sqlstring := `UPDATE tb_Party SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
-    WHERE inbMserviceId = ? AND inbPartyId = ? AND intVersion = ? AND  bitIsDeleted= 0`
+	parties, err := s.store.GetDeletedParties(ctx, req.GetMserviceId())
 
-	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		level.Error(s.logger).Log("what", "GetDeletedParties", "error", err)
 		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+		resp.ErrorMessage = err.Error()
 		return resp, nil
 	}
 
-	defer stmt.Close()
+	for _, party := range parties {
+		party.PartyTypeName = partyTypeMap[party.PartyType]
+	}
+
+	resp.Parties = parties
+
+	return resp, nil
+}
+
+// restore a deleted party, optionally with the addresses and phones deleted with it
+func (s *addrService) RestoreParty(ctx context.Context, req *pb.RestorePartyRequest) (*pb.RestorePartyResponse, error) {
+	resp := &pb.RestorePartyResponse{}
+
+	var version int32
+
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.RestoreParty(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetVersion())
+		if err != nil {
+			return err
+		}
+
+		if req.GetRestoreChildren() {
+			err = tx.RestoreAddresses(ctx, req.GetMserviceId(), req.GetPartyId())
+			if err != nil {
+				return err
+			}
+
+			err = tx.RestorePhones(ctx, req.GetMserviceId(), req.GetPartyId())
+			if err != nil {
+				return err
+			}
+		}
+
+		return recordHistory(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+	})
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetMserviceId(), req.GetPartyId(), req.GetVersion())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.unrestorableParty(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetVersion()); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentParty = current
 		} else {
 			resp.ErrorCode = 404
 			resp.ErrorMessage = "not found"
@@ -278,18 +354,17 @@ func (s *addrService) DeleteParty(ctx context.Context, req *pb.DeletePartyReques
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		level.Error(s.logger).Log("what", "RestoreParty", "error", err)
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get party by id
 func (s *addrService) GetParty(ctx context.Context, req *pb.GetPartyRequest) (*pb.GetPartyResponse, error) {
 	resp := &pb.GetPartyResponse{}
 
-	gResp, party := s.GetPartyHelper(req.GetPartyId(), req.GetMserviceId())
+	gResp, party := s.GetPartyHelper(ctx, req.GetMserviceId(), req.GetPartyId())
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	if gResp.ErrorCode == 0 {
@@ -304,159 +379,173 @@ func (s *addrService) GetParty(ctx context.Context, req *pb.GetPartyRequest) (*p
 func (s *addrService) GetParties(ctx context.Context, req *pb.GetPartiesRequest) (*pb.GetPartiesResponse, error) {
 	resp := &pb.GetPartiesResponse{}
 
-	sqlstring := `SELECT inbPartyId, dtmCreated, dtmModified, intVersion, inbMserviceId, intPartyType, chvLastName, 
-	chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail FROM tb_Party WHERE inbMserviceId = ? AND 
-	bitIsDeleted = 0`
+	var invalidFields []string
+
+	if !addrstore.IsValidPartyOrder(req.GetOrderBy()) {
+		invalidFields = append(invalidFields, "order_by")
+	}
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	if req.GetPageSize() < 0 {
+		invalidFields = append(invalidFields, "page_size")
+	}
+
+	var after *addrstore.PartyCursor
+	if req.GetPageToken() != "" {
+		var orderBy string
+		var err error
+		orderBy, after, err = decodePageToken(req.GetPageToken())
+		if (err != nil) || (orderBy != req.GetOrderBy()) {
+			invalidFields = append(invalidFields, "page_token")
+		}
+	}
+
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
 		return resp, nil
 	}
 
-	defer stmt.Close()
+	pageSize := int(req.GetPageSize())
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	limit := 0
+	if pageSize > 0 {
+		// fetch one extra party to find out if there is another page
+		limit = pageSize + 1
+	}
 
-	rows, err := stmt.Query(req.GetMserviceId())
+	parties, err := s.store.GetParties(ctx, req.GetMserviceId(), req.GetOrderBy(), after, limit)
 
 	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
+		level.Error(s.logger).Log("what", "GetParties", "error", err)
 		resp.ErrorCode = 500
 		resp.ErrorMessage = err.Error()
 		return resp, nil
 	}
 
-	defer rows.Close()
-
-	for rows.Next() {
-		var created string
-		var modified string
-		var party pb.Party
-
-		err = rows.Scan(&party.PartyId, &created, &modified, &party.Version, &party.MserviceId, &party.PartyType,
-			&party.LastName, &party.MiddleName, &party.FirstName, &party.Nickname, &party.Company, &party.Email)
-
-		if err == nil {
-			party.Created = dml.DateTimeFromString(created)
-			party.Modified = dml.DateTimeFromString(modified)
-			party.PartyTypeName = partyTypeMap[party.PartyType]
+	if (pageSize > 0) && (len(parties) > pageSize) {
+		parties = parties[:pageSize]
+		last := parties[pageSize-1]
+		resp.NextPageToken = encodePageToken(req.GetOrderBy(), addrstore.NewPartyCursor(req.GetOrderBy(), last))
+	}
 
-			resp.Parties = append(resp.Parties, &party)
-		} else {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-		}
+	for _, party := range parties {
+		party.PartyTypeName = partyTypeMap[party.PartyType]
 	}
 
+	resp.Parties = parties
+
 	return resp, nil
 
 }
 
-// get party wrapper by id
-func (s *addrService) GetPartyWrapper(ctx context.Context, req *pb.GetPartyWrapperRequest) (*pb.GetPartyWrapperResponse, error) {
-	resp := &pb.GetPartyWrapperResponse{}
+// search parties by name, contact, phone or address fields
+func (s *addrService) SearchParties(ctx context.Context, req *pb.SearchPartiesRequest) (*pb.SearchPartiesResponse, error) {
+	resp := &pb.SearchPartiesResponse{}
 
-	gResp, party := s.GetPartyHelper(req.GetPartyId(), req.GetMserviceId())
-	if gResp.ErrorCode != 0 {
-		resp.ErrorCode = gResp.ErrorCode
-		resp.ErrorMessage = gResp.ErrorMessage
-		return resp, nil
+	if req.GetPartyType() != 0 {
+		if _, ok := partyTypeMap[req.GetPartyType()]; !ok {
+			resp.ErrorCode = 406
+			resp.ErrorMessage = "invalid fields: party_type"
+			return resp, nil
+		}
 	}
 
-	wrap := convertPartyToWrapper(party)
-	sqlstring1 := `SELECT inbPartyId, intAddressType, dtmCreated, dtmModified, intVersion, inbMserviceId, chvAddress1, 
-    chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode FROM tb_Address WHERE
-    inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = 0`
+	parties, err := s.store.SearchParties(ctx, req)
 
-	stmt1, err := s.db.Prepare(sqlstring1)
 	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		level.Error(s.logger).Log("what", "SearchParties", "error", err)
 		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+		resp.ErrorMessage = err.Error()
 		return resp, nil
 	}
 
-	defer stmt1.Close()
-
-	rows1, err := stmt1.Query(req.GetMserviceId(), req.GetPartyId())
-
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		return resp, nil
+	for _, party := range parties {
+		party.PartyTypeName = partyTypeMap[party.PartyType]
 	}
 
-	defer rows1.Close()
+	resp.Parties = parties
 
-	for rows1.Next() {
-		var created string
-		var modified string
-		var addr pb.Address
+	return resp, nil
+}
 
-		err = rows1.Scan(&addr.PartyId, &addr.AddressType, &created, &modified, &addr.Version, &addr.MserviceId,
-			&addr.Address_1, &addr.Address_2, &addr.City, &addr.State, &addr.PostalCode, &addr.CountryCode)
+// stream all party wrappers for the mservice account, one per message
+func (s *addrService) StreamPartyWrappers(req *pb.StreamPartyWrappersRequest, stream pb.MServiceAddrbook_StreamPartyWrappersServer) error {
+	ctx := stream.Context()
+	var afterPartyId int64
 
-		if err == nil {
-			addr.Created = dml.DateTimeFromString(created)
-			addr.Modified = dml.DateTimeFromString(modified)
-			addr.AddressTypeName = addrTypeMap[addr.AddressType]
-			wrap.Addresses = append(wrap.Addresses, &addr)
-		} else {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
+	for {
+		wraps, err := s.GetPartyWrapperBatch(ctx, req.GetMserviceId(), afterPartyId)
+		if err != nil {
+			resp := &pb.StreamPartyWrappersResponse{}
 			resp.ErrorCode = 500
 			resp.ErrorMessage = err.Error()
-			return resp, nil
+			return stream.Send(resp)
+		}
+
+		for _, wrap := range wraps {
+			err = stream.Send(&pb.StreamPartyWrappersResponse{PartyWrapper: wrap})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(wraps) < streamBatchSize {
+			return nil
 		}
+
+		afterPartyId = wraps[len(wraps)-1].GetPartyId()
 	}
+}
 
-	sqlstring2 := `SELECT inbPartyId, intPhoneType, dtmCreated, dtmModified, intVersion, inbMserviceId, 
-    chvPhoneNumber FROM tb_Phone WHERE inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = 0 ORDER BY intPhoneType`
+// get party wrapper by id, or as it was at an earlier time
+func (s *addrService) GetPartyWrapper(ctx context.Context, req *pb.GetPartyWrapperRequest) (*pb.GetPartyWrapperResponse, error) {
+	if req.GetAsOf() != nil {
+		return s.getPartyWrapperAsOf(ctx, req), nil
+	}
 
-	stmt2, err := s.db.Prepare(sqlstring2)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	resp := &pb.GetPartyWrapperResponse{}
+
+	gResp, party := s.GetPartyHelper(ctx, req.GetMserviceId(), req.GetPartyId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 		return resp, nil
 	}
 
-	defer stmt2.Close()
-
-	rows2, err := stmt2.Query(req.GetMserviceId(), req.GetPartyId())
+	wrap := convertPartyToWrapper(party)
+	addrs, err := s.store.GetAddresses(ctx, req.GetMserviceId(), req.GetPartyId())
 
 	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
+		level.Error(s.logger).Log("what", "GetAddresses", "error", err)
 		resp.ErrorCode = 500
 		resp.ErrorMessage = err.Error()
 		return resp, nil
 	}
 
-	defer rows2.Close()
+	for _, addr := range addrs {
+		addr.AddressTypeName = addrTypeMap[addr.AddressType]
+	}
 
-	for rows2.Next() {
-		var created string
-		var modified string
-		var phone pb.Phone
+	wrap.Addresses = addrs
 
-		err = rows2.Scan(&phone.PartyId, &phone.PhoneType, &created, &modified, &phone.Version, &phone.MserviceId,
-			&phone.PhoneNumber)
+	phones, err := s.store.GetPhones(ctx, req.GetMserviceId(), req.GetPartyId())
 
-		if err == nil {
-			phone.Created = dml.DateTimeFromString(created)
-			phone.Modified = dml.DateTimeFromString(modified)
-			phone.PhoneTypeName = phoneTypeMap[phone.GetPhoneType()]
-			wrap.Phones = append(wrap.Phones, &phone)
-		} else {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
-		}
+	if err != nil {
+		level.Error(s.logger).Log("what", "GetPhones", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
 	}
 
+	for _, phone := range phones {
+		phone.PhoneTypeName = phoneTypeMap[phone.GetPhoneType()]
+	}
+
+	wrap.Phones = phones
+
 	resp.PartyWrapper = wrap
 
 	return resp, nil
@@ -0,0 +1,348 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// A patch reads the live record, replaces the fields named in the update mask and writes it back
+// under the usual version check. Only the patched fields, and those checked against them, are
+// validated, so a patch is not refused for an older value it leaves alone.
+
+var partyPatchFields = []string{"party_type", "last_name", "middle_name", "first_name", "nickname", "company", "email"}
+var addressPatchFields = []string{"address_1", "address_2", "city", "state", "postal_code", "country_code"}
+var phonePatchFields = []string{"phone_number"}
+
+// update the fields of an existing party listed in the update mask
+func (s *addrService) PatchParty(ctx context.Context, req *pb.PatchPartyRequest) (*pb.PatchPartyResponse, error) {
+	resp := &pb.PatchPartyResponse{}
+
+	paths := maskPaths(req.GetUpdateMask(), partyPatchFields)
+	if paths == nil {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: update_mask"
+		return resp, nil
+	}
+
+	party, err := s.store.GetParty(ctx, req.GetMserviceId(), req.GetPartyId())
+	if err == addrstore.ErrNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	} else if err != nil {
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "PatchParty", "error", err)
+		return resp, nil
+	}
+
+	if party.GetVersion() != req.GetVersion() {
+		party.PartyTypeName = partyTypeMap[party.PartyType]
+		resp.ErrorCode = 412
+		resp.ErrorMessage = "version conflict"
+		resp.CurrentVersion = party.GetVersion()
+		resp.CurrentParty = party
+		return resp, nil
+	}
+
+	if paths["party_type"] {
+		party.PartyType = req.GetPartyType()
+	}
+
+	if paths["last_name"] {
+		party.LastName = req.GetLastName()
+	}
+
+	if paths["middle_name"] {
+		party.MiddleName = req.GetMiddleName()
+	}
+
+	if paths["first_name"] {
+		party.FirstName = req.GetFirstName()
+	}
+
+	if paths["nickname"] {
+		party.Nickname = req.GetNickname()
+	}
+
+	if paths["company"] {
+		party.Company = req.GetCompany()
+	}
+
+	if paths["email"] {
+		party.Email = req.GetEmail()
+	}
+
+	// a business needs a company, so a new party type checks the company as well
+	invalidFields := patchedInvalidFields(validateParty(party), paths, map[string]string{"company": "party_type"})
+
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return resp, nil
+	}
+
+	var version int32
+
+	err = s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.UpdateParty(ctx, party)
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, party.MserviceId, party.PartyId)
+	})
+
+	if err == nil {
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingParty(ctx, party.MserviceId, party.PartyId, party.Version); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentParty = current
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "PatchParty", "error", err)
+	}
+
+	return resp, nil
+}
+
+// update the fields of an existing address listed in the update mask
+func (s *addrService) PatchAddress(ctx context.Context, req *pb.PatchAddressRequest) (*pb.PatchAddressResponse, error) {
+	resp := &pb.PatchAddressResponse{}
+
+	paths := maskPaths(req.GetUpdateMask(), addressPatchFields)
+	if paths == nil {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: update_mask"
+		return resp, nil
+	}
+
+	addr, err := s.store.GetAddress(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetAddressType())
+	if err == addrstore.ErrNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	} else if err != nil {
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "PatchAddress", "error", err)
+		return resp, nil
+	}
+
+	if addr.GetVersion() != req.GetVersion() {
+		addr.AddressTypeName = addrTypeMap[addr.AddressType]
+		resp.ErrorCode = 412
+		resp.ErrorMessage = "version conflict"
+		resp.CurrentVersion = addr.GetVersion()
+		resp.CurrentAddress = addr
+		return resp, nil
+	}
+
+	if paths["address_1"] {
+		addr.Address_1 = req.GetAddress_1()
+	}
+
+	if paths["address_2"] {
+		addr.Address_2 = req.GetAddress_2()
+	}
+
+	if paths["city"] {
+		addr.City = req.GetCity()
+	}
+
+	if paths["state"] {
+		addr.State = req.GetState()
+	}
+
+	if paths["postal_code"] {
+		addr.PostalCode = req.GetPostalCode()
+	}
+
+	if paths["country_code"] {
+		addr.CountryCode = req.GetCountryCode()
+	}
+
+	// the postal code format depends on the country, so a new country checks the postal code as well
+	invalidFields := patchedInvalidFields(validateAddress(addr), paths, map[string]string{"postal_code": "country_code"})
+
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return resp, nil
+	}
+
+	var version int32
+
+	err = s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.UpdateAddress(ctx, addr)
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, addr.MserviceId, addr.PartyId)
+	})
+
+	if err == nil {
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingAddress(ctx, addr.MserviceId, addr.PartyId, addr.AddressType, addr.Version); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentAddress = current
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "PatchAddress", "error", err)
+	}
+
+	return resp, nil
+}
+
+// update the fields of an existing phone listed in the update mask
+func (s *addrService) PatchPhone(ctx context.Context, req *pb.PatchPhoneRequest) (*pb.PatchPhoneResponse, error) {
+	resp := &pb.PatchPhoneResponse{}
+
+	paths := maskPaths(req.GetUpdateMask(), phonePatchFields)
+	if paths == nil {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: update_mask"
+		return resp, nil
+	}
+
+	phone, err := s.store.GetPhone(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetPhoneType())
+	if err == addrstore.ErrNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	} else if err != nil {
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "PatchPhone", "error", err)
+		return resp, nil
+	}
+
+	if phone.GetVersion() != req.GetVersion() {
+		phone.PhoneTypeName = phoneTypeMap[phone.PhoneType]
+		resp.ErrorCode = 412
+		resp.ErrorMessage = "version conflict"
+		resp.CurrentVersion = phone.GetVersion()
+		resp.CurrentPhone = phone
+		return resp, nil
+	}
+
+	if paths["phone_number"] {
+		phone.PhoneNumber = req.GetPhoneNumber()
+	}
+
+	invalidFields := patchedInvalidFields(validatePhone(phone), paths, nil)
+
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return resp, nil
+	}
+
+	var version int32
+
+	err = s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.UpdatePhone(ctx, phone)
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, phone.MserviceId, phone.PartyId)
+	})
+
+	if err == nil {
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingPhone(ctx, phone.MserviceId, phone.PartyId, phone.PhoneType, phone.Version); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentPhone = current
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "PatchPhone", "error", err)
+	}
+
+	return resp, nil
+}
+
+// Helper to get the paths of an update mask as a set, nil if the mask is empty or names a field
+// that a patch cannot update.
+func maskPaths(mask *fieldmaskpb.FieldMask, patchable []string) map[string]bool {
+	allowed := make(map[string]bool)
+	for _, field := range patchable {
+		allowed[field] = true
+	}
+
+	paths := make(map[string]bool)
+	for _, path := range mask.GetPaths() {
+		if !allowed[path] {
+			return nil
+		}
+		paths[path] = true
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return paths
+}
+
+// Helper to keep the invalid fields of a patched record that are in the update mask, or that are
+// checked against a field in it, as given by checkedWith.
+func patchedInvalidFields(invalidFields []string, paths map[string]bool, checkedWith map[string]string) []string {
+	var patched []string
+
+	for _, field := range invalidFields {
+		if paths[field] || paths[checkedWith[field]] {
+			patched = append(patched, field)
+		}
+	}
+
+	return patched
+}
@@ -0,0 +1,182 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+)
+
+// A create_party, create_address or create_phone call with an idempotency-key in its metadata saves
+// its response with the key, in the same transaction as the create. A repeat of the call with the
+// same key and request within the window of the mservice account gets the saved response back
+// instead of creating another record.
+
+// Metadata header holding the idempotency key of a call.
+const idempotencyKeyHeader = "idempotency-key"
+
+// Printable ASCII without spaces, as sent in a metadata header.
+var validIdempotencyKey = regexp.MustCompile("^[!-~]{1,128}$")
+
+// Returned when an idempotency key was used for another request within its window.
+var errKeyReused = errors.New("idempotency key already used for another request")
+
+// Helper to get the idempotency key of a call, empty if none or if keys are off for the account.
+// Returns false if the key is not valid.
+func (s *addrService) idempotencyKey(ctx context.Context, mserviceId int64) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", true
+	}
+
+	values := md.Get(idempotencyKeyHeader)
+	if (len(values) == 0) || (s.getIdempotencyWindow(mserviceId) <= 0) {
+		return "", true
+	}
+
+	if (len(values) > 1) || !validIdempotencyKey.MatchString(values[0]) {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+// Helper to get how long create responses are kept for the idempotency keys of an account.
+func (s *addrService) getIdempotencyWindow(mserviceId int64) time.Duration {
+	if window, ok := s.accountIdempotencyWindows[mserviceId]; ok {
+		return window
+	}
+
+	return s.idempotencyWindow
+}
+
+// Helper to run a create in a transaction, saving the response it returns with the idempotency key,
+// if not empty. If the key was already used for the same request, the saved response is unmarshalled
+// into resp instead, and replayed is true; errKeyReused is returned if it was used for another.
+func (s *addrService) createOnce(ctx context.Context, mserviceId int64, key string, rpc string, req proto.Message,
+	resp proto.Message, create func(tx addrstore.Store) (proto.Message, error)) (bool, error) {
+	if key == "" {
+		return false, s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+			_, err := create(tx)
+			return err
+		})
+	}
+
+	hash := requestHash(req)
+
+	replayed := false
+
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		replayed, err = replayCreate(ctx, tx, mserviceId, key, rpc, hash, resp)
+		if replayed || (err != nil) {
+			return err
+		}
+
+		created, err := create(tx)
+		if err != nil {
+			return err
+		}
+
+		response, err := protojson.Marshal(created)
+		if err != nil {
+			return err
+		}
+
+		entry := addrstore.IdempotencyEntry{}
+		entry.MserviceId = mserviceId
+		entry.Key = key
+		entry.Rpc = rpc
+		entry.RequestHash = hash
+		entry.Response = string(response)
+		entry.Expires = time.Now().Add(s.getIdempotencyWindow(mserviceId))
+
+		return tx.SaveIdempotencyEntry(ctx, &entry)
+	})
+
+	if err == addrstore.ErrConflict {
+		// a concurrent call with the same key may have saved its response first
+		found, findErr := replayCreate(ctx, s.store, mserviceId, key, rpc, hash, resp)
+		if found || (findErr == errKeyReused) {
+			return found, findErr
+		}
+	}
+
+	return replayed, err
+}
+
+// Helper to unmarshal the saved response for an idempotency key into resp, returning false if there
+// is none, and errKeyReused if it is for another request.
+func replayCreate(ctx context.Context, store addrstore.Store, mserviceId int64, key string, rpc string, hash string,
+	resp proto.Message) (bool, error) {
+	entry, err := store.GetIdempotencyEntry(ctx, mserviceId, key)
+	if err == addrstore.ErrNotFound {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if (entry.Rpc != rpc) || (entry.RequestHash != hash) {
+		return false, errKeyReused
+	}
+
+	return true, protojson.Unmarshal([]byte(entry.Response), resp)
+}
+
+// Helper to hash a request, to tell a repeat from another request with the same idempotency key.
+func requestHash(req proto.Message) string {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Start removing expired idempotency keys every interval in the background, until ctx is done.
+func (s *addrService) StartIdempotencyPurger(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			removed, err := s.store.PurgeIdempotencyEntries(ctx, time.Now())
+			if err != nil {
+				level.Error(s.logger).Log("what", "PurgeIdempotencyEntries", "error", err)
+			} else if removed > 0 {
+				level.Info(s.logger).Log("what", "PurgeIdempotencyEntries", "removed", removed)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -15,52 +15,37 @@ package addrservice
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
-	"github.com/gaterace/dml-go/pkg/dml"
 	"github.com/go-kit/kit/log/level"
 
-	_ "github.com/go-sql-driver/mysql"
-
+	"github.com/gaterace/addrbook/pkg/addrstore"
 	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+	"google.golang.org/protobuf/proto"
 )
 
 // create a new address for a party
 func (s *addrService) CreateAddress(ctx context.Context, req *pb.CreateAddressRequest) (*pb.CreateAddressResponse, error) {
 	resp := &pb.CreateAddressResponse{}
 
+	addr := pb.Address{}
+	addr.MserviceId = req.GetMserviceId()
+	addr.PartyId = req.GetPartyId()
+	addr.AddressType = req.GetAddressType()
+	addr.Address_1 = req.GetAddress_1()
+	addr.Address_2 = req.GetAddress_2()
+	addr.City = req.GetCity()
+	addr.State = req.GetState()
+	addr.PostalCode = req.GetPostalCode()
+	addr.CountryCode = req.GetCountryCode()
+
 	// validate all inputs
-	var invalidFields []string
+	invalidFields := validateAddress(&addr)
 
-	_, ok := addrTypeMap[req.GetAddressType()]
+	key, ok := s.idempotencyKey(ctx, addr.MserviceId)
 	if !ok {
-		invalidFields = append(invalidFields, "address_type")
-	}
-
-	if !isValidAddress(req.GetAddress_1()) {
-		invalidFields = append(invalidFields, "address_1")
-	}
-
-	if (req.GetAddress_2() != "") && !isValidAddress(req.GetAddress_2()) {
-		invalidFields = append(invalidFields, "address_2")
-	}
-
-	if !isValidCity(req.GetCity()) {
-		invalidFields = append(invalidFields, "city")
-	}
-
-	if !isValidState(req.GetState()) {
-		invalidFields = append(invalidFields, "state")
-	}
-
-	if !isValidPostalCode(req.GetPostalCode(), req.GetCountryCode()) {
-		invalidFields = append(invalidFields, "postal_code")
-	}
-
-	if !isValidCountryCode(req.GetCountryCode()) {
-		invalidFields = append(invalidFields, "country_code")
+		invalidFields = append(invalidFields, "idempotency_key")
 	}
 
 	if len(invalidFields) > 0 {
@@ -69,30 +54,44 @@ func (s *addrService) CreateAddress(ctx context.Context, req *pb.CreateAddressRe
 		return resp, nil
 	}
 
-	sqlstring := `INSERT INTO tb_Address
-	(inbPartyId, intAddressType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
-    chvAddress1, chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode) VALUES 
-    (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
+	var version int32
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+	// the party must exist and not be deleted
+	replayed, err := s.createOnce(ctx, addr.MserviceId, key, "create_address", req, resp,
+		func(tx addrstore.Store) (proto.Message, error) {
+			err := requireLiveParty(ctx, tx, addr.MserviceId, addr.PartyId)
+			if err != nil {
+				return nil, err
+			}
 
-	defer stmt.Close()
+			version, err = tx.CreateAddress(ctx, &addr)
+			if err != nil {
+				return nil, err
+			}
 
-	_, err = stmt.Exec(req.GetPartyId(), req.GetAddressType(), req.GetMserviceId(), req.GetAddress_1(),
-		req.GetAddress_2(), req.GetCity(), req.GetState(), req.GetPostalCode(), req.GetCountryCode())
+			err = recordHistory(ctx, tx, addr.MserviceId, addr.PartyId)
+			return &pb.CreateAddressResponse{Version: version}, err
+		})
+
+	if replayed {
+		return resp, nil
+	}
 
 	if err == nil {
-		resp.Version = 1
+		resp.Version = version
+	} else if err == errKeyReused {
+		resp.ErrorCode = 409
+		resp.ErrorMessage = err.Error()
+	} else if err == errPartyNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = err.Error()
+	} else if err == addrstore.ErrConflict {
+		resp.ErrorCode = 409
+		resp.ErrorMessage = "address already exists"
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
+		level.Error(s.logger).Log("what", "CreateAddress", "error", err)
 	}
 
 	return resp, nil
@@ -102,37 +101,20 @@ func (s *addrService) CreateAddress(ctx context.Context, req *pb.CreateAddressRe
 func (s *addrService) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRequest) (*pb.UpdateAddressResponse, error) {
 	resp := &pb.UpdateAddressResponse{}
 
-	// validate all inputs
-	var invalidFields []string
-
-	_, ok := addrTypeMap[req.GetAddressType()]
-	if !ok {
-		invalidFields = append(invalidFields, "address_type")
-	}
-
-	if !isValidName(req.GetAddress_1()) {
-		invalidFields = append(invalidFields, "address_1")
-	}
-
-	if (req.GetAddress_2() != "") && !isValidName(req.GetAddress_2()) {
-		invalidFields = append(invalidFields, "address_2")
-	}
-
-	if !isValidCity(req.GetCity()) {
-		invalidFields = append(invalidFields, "city")
-	}
-
-	if !isValidState(req.GetState()) {
-		invalidFields = append(invalidFields, "state")
-	}
-
-	if !isValidPostalCode(req.GetPostalCode(), req.GetCountryCode()) {
-		invalidFields = append(invalidFields, "postal_code")
-	}
+	addr := pb.Address{}
+	addr.MserviceId = req.GetMserviceId()
+	addr.PartyId = req.GetPartyId()
+	addr.AddressType = req.GetAddressType()
+	addr.Version = req.GetVersion()
+	addr.Address_1 = req.GetAddress_1()
+	addr.Address_2 = req.GetAddress_2()
+	addr.City = req.GetCity()
+	addr.State = req.GetState()
+	addr.PostalCode = req.GetPostalCode()
+	addr.CountryCode = req.GetCountryCode()
 
-	if !isValidCountryCode(req.GetCountryCode()) {
-		invalidFields = append(invalidFields, "country_code")
-	}
+	// validate all inputs
+	invalidFields := validateAddress(&addr)
 
 	if len(invalidFields) > 0 {
 		resp.ErrorCode = 406
@@ -140,28 +122,26 @@ func (s *addrService) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRe
 		return resp, nil
 	}
 
-	sqlstring := `UPDATE tb_Address SET dtmModified = NOW(), intVersion = ?, chvAddress1 = ?, chvAddress2 = ?,
-    chvCity = ?, chvState = ?, chvPostalCode= ?, chvCountryCode = ? WHERE
-    inbMserviceId = ? AND inbPartyId = ? AND intAddressType = ? AND intVersion = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+	var version int32
 
-	defer stmt.Close()
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.UpdateAddress(ctx, &addr)
+		if err != nil {
+			return err
+		}
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetAddress_1(), req.GetAddress_2(), req.GetCity(), req.GetState(),
-		req.GetPostalCode(), req.GetCountryCode(), req.GetMserviceId(), req.GetPartyId(), req.GetAddressType(),
-		req.GetVersion())
+		return recordHistory(ctx, tx, addr.MserviceId, addr.PartyId)
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingAddress(ctx, addr.MserviceId, addr.PartyId, addr.AddressType, addr.Version); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentAddress = current
 		} else {
 			resp.ErrorCode = 404
 			resp.ErrorMessage = "not found"
@@ -169,8 +149,7 @@ func (s *addrService) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRe
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		level.Error(s.logger).Log("what", "UpdateAddress", "error", err)
 	}
 
 	return resp, nil
@@ -180,26 +159,26 @@ func (s *addrService) UpdateAddress(ctx context.Context, req *pb.UpdateAddressRe
 func (s *addrService) DeleteAddress(ctx context.Context, req *pb.DeleteAddressRequest) (*pb.DeleteAddressResponse, error) {
 	resp := &pb.DeleteAddressResponse{}
 
-	sqlstring := `UPDATE tb_Address SET dtmModified = NOW(), intVersion = ?, bitIsDeleted = 1 WHERE 
-    inbMserviceId = ? AND inbPartyId = ? AND intAddressType = ? AND intVersion = ? AND bitIsDeleted = 0`
+	var version int32
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.DeleteAddress(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetAddressType(), req.GetVersion())
+		if err != nil {
+			return err
+		}
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetMserviceId(), req.GetPartyId(), req.GetAddressType(),
-		req.GetVersion())
+		return recordHistory(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingAddress(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetAddressType(), req.GetVersion()); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentAddress = current
 		} else {
 			resp.ErrorCode = 404
 			resp.ErrorMessage = "not found"
@@ -207,51 +186,74 @@ func (s *addrService) DeleteAddress(ctx context.Context, req *pb.DeleteAddressRe
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		level.Error(s.logger).Log("what", "DeleteAddress", "error", err)
 	}
 
 	return resp, nil
 }
 
-// get an address for a party by id
-func (s *addrService) GetAddress(ctx context.Context, req *pb.GetAddressRequest) (*pb.GetAddressResponse, error) {
-	resp := &pb.GetAddressResponse{}
+// restore a deleted address for a party
+func (s *addrService) RestoreAddress(ctx context.Context, req *pb.RestoreAddressRequest) (*pb.RestoreAddressResponse, error) {
+	resp := &pb.RestoreAddressResponse{}
 
-	sqlstring := `SELECT inbPartyId, intAddressType, dtmCreated, dtmModified, intVersion, inbMserviceId, chvAddress1, 
-    chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode FROM tb_Address WHERE
-    inbMserviceId = ? AND inbPartyId = ? AND intAddressType = ? AND bitIsDeleted = 0`
+	var version int32
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
+	// the party must exist and not be deleted
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		err := requireLiveParty(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+		if err != nil {
+			return err
+		}
+
+		version, err = tx.RestoreAddress(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetAddressType(), req.GetVersion())
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+	})
+
+	if err == nil {
+		resp.Version = version
+	} else if err == errPartyNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = err.Error()
+	} else if err == addrstore.ErrNotFound {
+		if current := s.unrestorableAddress(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetAddressType(),
+			req.GetVersion()); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentAddress = current
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "RestoreAddress", "error", err)
 	}
 
-	defer stmt.Close()
+	return resp, nil
+}
 
-	var created string
-	var modified string
-	var addr pb.Address
+// get an address for a party by id
+func (s *addrService) GetAddress(ctx context.Context, req *pb.GetAddressRequest) (*pb.GetAddressResponse, error) {
+	resp := &pb.GetAddressResponse{}
 
-	err = stmt.QueryRow(req.GetMserviceId(), req.GetPartyId(), req.GetAddressType()).Scan(&addr.PartyId,
-		&addr.AddressType, &created, &modified, &addr.Version, &addr.MserviceId, &addr.Address_1,
-		&addr.Address_2, &addr.City, &addr.State, &addr.PostalCode, &addr.CountryCode)
+	addr, err := s.store.GetAddress(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetAddressType())
 
 	if err == nil {
-		addr.Created = dml.DateTimeFromString(created)
-		addr.Modified = dml.DateTimeFromString(modified)
 		addr.AddressTypeName = addrTypeMap[addr.AddressType]
 
 		resp.ErrorCode = 0
-		resp.Address = &addr
-	} else if err == sql.ErrNoRows {
+		resp.Address = addr
+	} else if err == addrstore.ErrNotFound {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "not found"
 	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		level.Error(s.logger).Log("what", "GetAddress", "error", err)
 		resp.ErrorCode = 500
 		resp.ErrorMessage = err.Error()
 	}
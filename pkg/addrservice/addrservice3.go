@@ -15,32 +15,32 @@ package addrservice
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/gaterace/dml-go/pkg/dml"
 	"github.com/go-kit/kit/log/level"
 
-	_ "github.com/go-sql-driver/mysql"
-
+	"github.com/gaterace/addrbook/pkg/addrstore"
 	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+	"google.golang.org/protobuf/proto"
 )
 
 // create a new  phone
 func (s *addrService) CreatePhone(ctx context.Context, req *pb.CreatePhoneRequest) (*pb.CreatePhoneResponse, error) {
 	resp := &pb.CreatePhoneResponse{}
 
-	var invalidFields []string
+	phone := pb.Phone{}
+	phone.MserviceId = req.GetMserviceId()
+	phone.PartyId = req.GetPartyId()
+	phone.PhoneType = req.GetPhoneType()
+	phone.PhoneNumber = req.GetPhoneNumber()
 
-	_, ok := phoneTypeMap[req.GetPhoneType()]
-	if !ok {
-		invalidFields = append(invalidFields, "phone_type")
-	}
+	invalidFields := validatePhone(&phone)
 
-	if !isValidPhone(req.GetPhoneNumber()) {
-		invalidFields = append(invalidFields, "phone_number")
+	key, ok := s.idempotencyKey(ctx, phone.MserviceId)
+	if !ok {
+		invalidFields = append(invalidFields, "idempotency_key")
 	}
 
 	if len(invalidFields) > 0 {
@@ -49,27 +49,44 @@ func (s *addrService) CreatePhone(ctx context.Context, req *pb.CreatePhoneReques
 		return resp, nil
 	}
 
-	sqlstring := `INSERT INTO tb_Phone (inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, 
-    intVersion, inbMserviceId, chvPhoneNumber) VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?)`
+	var version int32
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+	// the party must exist and not be deleted
+	replayed, err := s.createOnce(ctx, phone.MserviceId, key, "create_phone", req, resp,
+		func(tx addrstore.Store) (proto.Message, error) {
+			err := requireLiveParty(ctx, tx, phone.MserviceId, phone.PartyId)
+			if err != nil {
+				return nil, err
+			}
 
-	defer stmt.Close()
+			version, err = tx.CreatePhone(ctx, &phone)
+			if err != nil {
+				return nil, err
+			}
 
-	_, err = stmt.Exec(req.GetPartyId(), req.GetPhoneType(), req.GetMserviceId(), req.GetPhoneNumber())
+			err = recordHistory(ctx, tx, phone.MserviceId, phone.PartyId)
+			return &pb.CreatePhoneResponse{Version: version}, err
+		})
+
+	if replayed {
+		return resp, nil
+	}
 
 	if err == nil {
-		resp.Version = 1
+		resp.Version = version
+	} else if err == errKeyReused {
+		resp.ErrorCode = 409
+		resp.ErrorMessage = err.Error()
+	} else if err == errPartyNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = err.Error()
+	} else if err == addrstore.ErrConflict {
+		resp.ErrorCode = 409
+		resp.ErrorMessage = "phone already exists"
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
+		level.Error(s.logger).Log("what", "CreatePhone", "error", err)
 	}
 
 	return resp, nil
@@ -79,28 +96,42 @@ func (s *addrService) CreatePhone(ctx context.Context, req *pb.CreatePhoneReques
 func (s *addrService) UpdatePhone(ctx context.Context, req *pb.UpdatePhoneRequest) (*pb.UpdatePhoneResponse, error) {
 	resp := &pb.UpdatePhoneResponse{}
 
-	// TODO: validate all inputs
+	phone := pb.Phone{}
+	phone.MserviceId = req.GetMserviceId()
+	phone.PartyId = req.GetPartyId()
+	phone.PhoneType = req.GetPhoneType()
+	phone.Version = req.GetVersion()
+	phone.PhoneNumber = req.GetPhoneNumber()
 
-	sqlstring := `UPDATE tb_Phone SET dtmModified = NOW(), intVersion = ?, chvPhoneNumber = ? WHERE
-    inbMserviceId = ? AND inbPartyId = ? AND intPhoneType = ? AND intVersion = ? AND bitIsDeleted = 0`
+	// validate all inputs
+	invalidFields := validatePhone(&phone)
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
 		return resp, nil
 	}
 
-	defer stmt.Close()
+	var version int32
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetPhoneNumber(), req.GetMserviceId(), req.GetPartyId(),
-		req.GetPhoneType(), req.GetVersion())
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.UpdatePhone(ctx, &phone)
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, phone.MserviceId, phone.PartyId)
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingPhone(ctx, phone.MserviceId, phone.PartyId, phone.PhoneType, phone.Version); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentPhone = current
 		} else {
 			resp.ErrorCode = 404
 			resp.ErrorMessage = "not found"
@@ -108,8 +139,7 @@ func (s *addrService) UpdatePhone(ctx context.Context, req *pb.UpdatePhoneReques
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		level.Error(s.logger).Log("what", "UpdatePhone", "error", err)
 	}
 
 	return resp, nil
@@ -119,26 +149,26 @@ func (s *addrService) UpdatePhone(ctx context.Context, req *pb.UpdatePhoneReques
 func (s *addrService) DeletePhone(ctx context.Context, req *pb.DeletePhoneRequest) (*pb.DeletePhoneResponse, error) {
 	resp := &pb.DeletePhoneResponse{}
 
-	sqlstring := `UPDATE tb_Phone SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1 WHERE
-    inbMserviceId = ? AND inbPartyId = ? AND intPhoneType = ? AND intVersion = ? AND bitIsDeleted = 0`
+	var version int32
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		version, err = tx.DeletePhone(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetPhoneType(), req.GetVersion())
+		if err != nil {
+			return err
+		}
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetMserviceId(), req.GetPartyId(),
-		req.GetPhoneType(), req.GetVersion())
+		return recordHistory(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+		resp.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if current := s.conflictingPhone(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetPhoneType(), req.GetVersion()); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentPhone = current
 		} else {
 			resp.ErrorCode = 404
 			resp.ErrorMessage = "not found"
@@ -146,54 +176,77 @@ func (s *addrService) DeletePhone(ctx context.Context, req *pb.DeletePhoneReques
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		level.Error(s.logger).Log("what", "DeletePhone", "error", err)
 	}
 
 	return resp, nil
 }
 
-// get a phone for a party by id
-func (s *addrService) GetPhone(ctx context.Context, req *pb.GetPhoneRequest) (*pb.GetPhoneResponse, error) {
-	resp := &pb.GetPhoneResponse{}
+// restore a deleted phone for a party
+func (s *addrService) RestorePhone(ctx context.Context, req *pb.RestorePhoneRequest) (*pb.RestorePhoneResponse, error) {
+	resp := &pb.RestorePhoneResponse{}
 
-	sqlstring := `SELECT inbPartyId, intPhoneType, dtmCreated, dtmModified, intVersion, inbMserviceId, 
-    chvPhoneNumber FROM tb_Phone WHERE inbMserviceId = ? AND inbPartyId = ? AND intPhoneType = ? AND bitIsDeleted = 0`
+	var version int32
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
+	// the party must exist and not be deleted
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		err := requireLiveParty(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+		if err != nil {
+			return err
+		}
+
+		version, err = tx.RestorePhone(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetPhoneType(), req.GetVersion())
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, req.GetMserviceId(), req.GetPartyId())
+	})
+
+	if err == nil {
+		resp.Version = version
+	} else if err == errPartyNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = err.Error()
+	} else if err == addrstore.ErrNotFound {
+		if current := s.unrestorablePhone(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetPhoneType(),
+			req.GetVersion()); current != nil {
+			resp.ErrorCode = 412
+			resp.ErrorMessage = "version conflict"
+			resp.CurrentVersion = current.GetVersion()
+			resp.CurrentPhone = current
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "RestorePhone", "error", err)
 	}
 
-	defer stmt.Close()
+	return resp, nil
+}
 
-	var created string
-	var modified string
-	var phone pb.Phone
+// get a phone for a party by id
+func (s *addrService) GetPhone(ctx context.Context, req *pb.GetPhoneRequest) (*pb.GetPhoneResponse, error) {
+	resp := &pb.GetPhoneResponse{}
 
-	err = stmt.QueryRow(req.GetMserviceId(), req.GetPartyId(), req.GetPhoneType()).Scan(&phone.PartyId,
-		&phone.PhoneType, &created, &modified, &phone.Version, &phone.MserviceId, &phone.PhoneNumber)
+	phone, err := s.store.GetPhone(ctx, req.GetMserviceId(), req.GetPartyId(), req.GetPhoneType())
 
 	if err == nil {
-		phone.Created = dml.DateTimeFromString(created)
-		phone.Modified = dml.DateTimeFromString(modified)
 		phone.PhoneTypeName = phoneTypeMap[phone.GetPhoneType()]
-		resp.Phone = &phone
-	} else if err == sql.ErrNoRows {
+		resp.Phone = phone
+	} else if err == addrstore.ErrNotFound {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "not found"
 	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		level.Error(s.logger).Log("what", "GetPhone", "error", err)
 		resp.ErrorCode = 500
 		resp.ErrorMessage = err.Error()
 	}
 
 	return resp, nil
-
-	return resp, nil
 }
 
 // get current server version and uptime - health check
@@ -0,0 +1,346 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/addrbook/pkg/addrcsv"
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	"github.com/gaterace/addrbook/pkg/addrvcard"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// export a party, or a page of all parties, as vCards
+func (s *addrService) ExportVcard(ctx context.Context, req *pb.ExportVcardRequest) (*pb.ExportVcardResponse, error) {
+	resp := &pb.ExportVcardResponse{}
+
+	version := req.GetVcardVersion()
+	if version == "" {
+		version = addrvcard.Version40
+	}
+
+	if !addrvcard.IsValidVersion(version) {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: vcard_version"
+		return resp, nil
+	}
+
+	var buf bytes.Buffer
+	var count int32
+
+	if req.GetPartyId() != 0 {
+		wResp, _ := s.GetPartyWrapper(ctx, &pb.GetPartyWrapperRequest{MserviceId: req.GetMserviceId(), PartyId: req.GetPartyId()})
+		if wResp.GetErrorCode() != 0 {
+			resp.ErrorCode = wResp.GetErrorCode()
+			resp.ErrorMessage = wResp.GetErrorMessage()
+			return resp, nil
+		}
+
+		addrvcard.Encode(&buf, wResp.GetPartyWrapper(), version)
+		count++
+	} else {
+		wraps, nextPageToken, invalidFields, err := s.getExportPage(ctx, req.GetMserviceId(), req.GetPageSize(),
+			req.GetPageToken())
+		if len(invalidFields) > 0 {
+			resp.ErrorCode = 406
+			resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+			return resp, nil
+		}
+
+		if err != nil {
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		for _, wrap := range wraps {
+			addrvcard.Encode(&buf, wrap, version)
+			count++
+		}
+
+		resp.NextPageToken = nextPageToken
+	}
+
+	resp.ContentType = addrvcard.ContentType
+	resp.VcardData = buf.Bytes()
+	resp.CardCount = count
+
+	return resp, nil
+}
+
+// Outcome of importing a single party wrapper.
+type importResult struct {
+	outcome       int32
+	partyId       int64
+	invalidFields []string
+	errorMessage  string
+}
+
+// Import a party wrapper unless it is invalid or has the email of an existing party or one
+// already in imported, which maps lowercase email to party identifier.
+func (s *addrService) importPartyWrapper(ctx context.Context, wrap *pb.PartyWrapper, dryRun bool,
+	imported map[string]int64) *importResult {
+	result := &importResult{}
+
+	for _, addr := range wrap.GetAddresses() {
+		addr.CountryCode = normalizeCountryCode(addr.GetCountryCode())
+	}
+
+	for _, phone := range wrap.GetPhones() {
+		phone.PhoneNumber = normalizePhone(phone.GetPhoneNumber())
+	}
+
+	invalidFields := validatePartyWrapper(wrap)
+	email := strings.ToLower(wrap.GetEmail())
+
+	if len(invalidFields) > 0 {
+		result.outcome = int32(pb.ImportOutcome_ImportRejected)
+		result.invalidFields = invalidFields
+		result.errorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return result
+	}
+
+	if partyId, ok := imported[email]; ok {
+		result.outcome = int32(pb.ImportOutcome_ImportSkippedDuplicate)
+		result.partyId = partyId
+		return result
+	}
+
+	dups, err := s.store.SearchParties(ctx, &pb.SearchPartiesRequest{MserviceId: wrap.GetMserviceId(), Email: email})
+	if err != nil {
+		level.Error(s.logger).Log("what", "SearchParties", "error", err)
+		result.outcome = int32(pb.ImportOutcome_ImportFailed)
+		result.errorMessage = err.Error()
+		return result
+	}
+
+	if len(dups) > 0 {
+		result.outcome = int32(pb.ImportOutcome_ImportSkippedDuplicate)
+		result.partyId = dups[0].GetPartyId()
+		return result
+	}
+
+	if dryRun {
+		result.outcome = int32(pb.ImportOutcome_ImportCreated)
+		imported[email] = 0
+		return result
+	}
+
+	partyId, err := s.createPartyWrapper(ctx, wrap)
+
+	if err != nil {
+		result.outcome = int32(pb.ImportOutcome_ImportFailed)
+		result.errorMessage = err.Error()
+	} else {
+		result.outcome = int32(pb.ImportOutcome_ImportCreated)
+		result.partyId = partyId
+		imported[email] = partyId
+	}
+
+	return result
+}
+
+// import parties with their addresses and phones from vCards
+func (s *addrService) ImportVcard(ctx context.Context, req *pb.ImportVcardRequest) (*pb.ImportVcardResponse, error) {
+	resp := &pb.ImportVcardResponse{}
+
+	wraps, err := addrvcard.Decode(bytes.NewReader(req.GetVcardData()))
+	if err != nil {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: vcard_data"
+		return resp, nil
+	}
+
+	imported := make(map[string]int64)
+
+	for i, wrap := range wraps {
+		wrap.MserviceId = req.GetMserviceId()
+		iResult := s.importPartyWrapper(ctx, wrap, req.GetDryRun(), imported)
+
+		result := &pb.VcardImportResult{}
+		result.CardIndex = int32(i + 1)
+		result.Outcome = iResult.outcome
+		result.OutcomeName = pb.ImportOutcome_name[iResult.outcome]
+		result.PartyId = iResult.partyId
+		result.Email = wrap.GetEmail()
+		result.InvalidFields = iResult.invalidFields
+		result.ErrorMessage = iResult.errorMessage
+
+		switch result.Outcome {
+		case int32(pb.ImportOutcome_ImportCreated):
+			resp.CreatedCount++
+		case int32(pb.ImportOutcome_ImportSkippedDuplicate):
+			resp.SkippedCount++
+		case int32(pb.ImportOutcome_ImportFailed):
+			resp.FailedCount++
+		default:
+			resp.RejectedCount++
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+// export a page of parties as CSV, one row per party with its addresses and phones
+func (s *addrService) ExportCsv(ctx context.Context, req *pb.ExportCsvRequest) (*pb.ExportCsvResponse, error) {
+	resp := &pb.ExportCsvResponse{}
+
+	wraps, nextPageToken, invalidFields, err := s.getExportPage(ctx, req.GetMserviceId(), req.GetPageSize(),
+		req.GetPageToken())
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return resp, nil
+	}
+
+	if err != nil {
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	var buf bytes.Buffer
+	var count int32
+
+	writer := addrcsv.NewWriter(&buf)
+
+	// the header row is only on the first page, so that the pages can be concatenated
+	if req.GetPageToken() != "" {
+		writer.OmitHeader()
+	}
+
+	for _, wrap := range wraps {
+		writer.Write(wrap)
+		count++
+	}
+
+	writer.Flush()
+
+	resp.ContentType = addrcsv.ContentType
+	resp.CsvData = buf.Bytes()
+	resp.RowCount = count
+	resp.NextPageToken = nextPageToken
+
+	return resp, nil
+}
+
+// Helper to get a page of party wrappers to export, in party identifier order, with the token for
+// the next page, or the invalid request fields.
+func (s *addrService) getExportPage(ctx context.Context, mserviceId int64, pageSize int32, pageToken string) ([]*pb.PartyWrapper,
+	string, []string, error) {
+	var invalidFields []string
+
+	if pageSize < 0 {
+		invalidFields = append(invalidFields, "page_size")
+	}
+
+	var afterPartyId int64
+	if pageToken != "" {
+		orderBy, after, err := decodePageToken(pageToken)
+		if (err != nil) || (orderBy != addrstore.OrderByPartyId) {
+			invalidFields = append(invalidFields, "page_token")
+		} else {
+			afterPartyId = after.PartyId
+		}
+	}
+
+	if len(invalidFields) > 0 {
+		return nil, "", invalidFields, nil
+	}
+
+	limit := int(pageSize)
+	if limit == 0 {
+		limit = streamBatchSize
+	} else if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	// fetch one extra party to find out if there is another page
+	wraps, err := s.getPartyWrappers(ctx, mserviceId, afterPartyId, limit+1)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	var nextPageToken string
+	if len(wraps) > limit {
+		wraps = wraps[:limit]
+		last := &addrstore.PartyCursor{PartyId: wraps[limit-1].GetPartyId()}
+		nextPageToken = encodePageToken(addrstore.OrderByPartyId, last)
+	}
+
+	return wraps, nextPageToken, nil, nil
+}
+
+// import parties with their addresses and phones from CSV
+func (s *addrService) ImportCsv(ctx context.Context, req *pb.ImportCsvRequest) (*pb.ImportCsvResponse, error) {
+	resp := &pb.ImportCsvResponse{}
+
+	var mapping []addrcsv.ColumnMap
+	for _, m := range req.GetColumnMap() {
+		mapping = append(mapping, addrcsv.ColumnMap{Source: m.GetSource(), Target: m.GetTarget(), Label: m.GetLabel()})
+	}
+
+	err := addrcsv.ValidateMapping(mapping)
+	if err != nil {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: column_map"
+		return resp, nil
+	}
+
+	rows, err := addrcsv.Decode(bytes.NewReader(req.GetCsvData()), mapping)
+	if err != nil {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: csv_data"
+		return resp, nil
+	}
+
+	imported := make(map[string]int64)
+
+	for _, row := range rows {
+		row.Wrap.MserviceId = req.GetMserviceId()
+		iResult := s.importPartyWrapper(ctx, row.Wrap, req.GetDryRun(), imported)
+
+		result := &pb.CsvImportResult{}
+		result.RowNumber = int32(row.Number)
+		result.Outcome = iResult.outcome
+		result.OutcomeName = pb.ImportOutcome_name[iResult.outcome]
+		result.PartyId = iResult.partyId
+		result.Email = row.Wrap.GetEmail()
+		result.InvalidFields = iResult.invalidFields
+		result.ErrorMessage = iResult.errorMessage
+
+		switch result.Outcome {
+		case int32(pb.ImportOutcome_ImportCreated):
+			resp.CreatedCount++
+		case int32(pb.ImportOutcome_ImportSkippedDuplicate):
+			resp.SkippedCount++
+		case int32(pb.ImportOutcome_ImportFailed):
+			resp.FailedCount++
+		default:
+			resp.RejectedCount++
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
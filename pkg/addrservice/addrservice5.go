@@ -0,0 +1,284 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// most results returned by BulkUpsertPartyWrappers, which returns results only for failed party wrappers
+const maxBulkUpsertResults = 1000
+
+// create or update parties with their addresses and phones, one transaction per party
+func (s *addrService) BulkUpsertPartyWrappers(stream pb.MServiceAddrbook_BulkUpsertPartyWrappersServer) error {
+	ctx := stream.Context()
+	resp := &pb.BulkUpsertPartyWrappersResponse{}
+
+	for index := int32(0); ; index++ {
+		wrap, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		result := s.bulkUpsertPartyWrapper(ctx, wrap)
+		result.Index = index
+
+		if result.GetErrorCode() == 0 {
+			if wrap.GetPartyId() == 0 {
+				resp.CreatedCount++
+			} else {
+				resp.UpdatedCount++
+			}
+
+			continue
+		}
+
+		resp.FailedCount++
+
+		if len(resp.Results) < maxBulkUpsertResults {
+			resp.Results = append(resp.Results, result)
+		}
+	}
+}
+
+// Helper to validate and upsert one party wrapper in its own transaction.
+func (s *addrService) bulkUpsertPartyWrapper(ctx context.Context, wrap *pb.PartyWrapper) *pb.BulkUpsertResult {
+	result := &pb.BulkUpsertResult{}
+	result.PartyId = wrap.GetPartyId()
+
+	invalidFields := validatePartyWrapper(wrap)
+
+	if len(invalidFields) > 0 {
+		result.ErrorCode = 406
+		result.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return result
+	}
+
+	var partyId int64
+	var version int32
+
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		partyId, version, err = upsertPartyWrapper(ctx, tx, wrap)
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, wrap.GetMserviceId(), partyId)
+	})
+
+	if err == nil {
+		result.PartyId = partyId
+		result.Version = version
+	} else if err == addrstore.ErrNotFound {
+		if currentVersion := s.conflictingWrapperVersion(ctx, wrap); currentVersion != 0 {
+			result.ErrorCode = 412
+			result.ErrorMessage = "version conflict"
+			result.CurrentVersion = currentVersion
+		} else {
+			result.ErrorCode = 404
+			result.ErrorMessage = "not found"
+		}
+	} else if err == addrstore.ErrConflict {
+		result.ErrorCode = 409
+		result.ErrorMessage = err.Error()
+	} else {
+		result.ErrorCode = 501
+		result.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "BulkUpsertPartyWrappers", "partyid", wrap.GetPartyId(), "error", err)
+	}
+
+	return result
+}
+
+// Helper to get the current version of the party, or else the first address or phone, of a party
+// wrapper that is live at another version than the wrapper has, 0 if none.
+func (s *addrService) conflictingWrapperVersion(ctx context.Context, wrap *pb.PartyWrapper) int32 {
+	if wrap.GetPartyId() == 0 {
+		return 0
+	}
+
+	if current := s.conflictingParty(ctx, wrap.GetMserviceId(), wrap.GetPartyId(), wrap.GetVersion()); current != nil {
+		return current.GetVersion()
+	}
+
+	for _, addr := range wrap.GetAddresses() {
+		if addr.GetVersion() == 0 {
+			continue
+		}
+
+		current := s.conflictingAddress(ctx, wrap.GetMserviceId(), wrap.GetPartyId(), addr.GetAddressType(), addr.GetVersion())
+		if current != nil {
+			return current.GetVersion()
+		}
+	}
+
+	for _, phone := range wrap.GetPhones() {
+		if phone.GetVersion() == 0 {
+			continue
+		}
+
+		current := s.conflictingPhone(ctx, wrap.GetMserviceId(), wrap.GetPartyId(), phone.GetPhoneType(), phone.GetVersion())
+		if current != nil {
+			return current.GetVersion()
+		}
+	}
+
+	return 0
+}
+
+// Helper to create a party wrapper with party_id 0, or else update the party, returning the
+// party identifier and version. Addresses and phones with version 0 are created, and the others
+// updated; those not in the wrapper are left as they are. The new addresses and the new phones of a
+// new party are each created in one batch insert, which covers this party only, not the stream.
+func upsertPartyWrapper(ctx context.Context, store addrstore.Store, wrap *pb.PartyWrapper) (int64, int32, error) {
+	party := convertWrapperToParty(wrap)
+
+	partyId := party.GetPartyId()
+	var version int32
+	var err error
+
+	if partyId == 0 {
+		partyId, err = store.CreateParty(ctx, party)
+		version = 1
+	} else {
+		version, err = store.UpdateParty(ctx, party)
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var newAddrs []*pb.Address
+
+	for _, addr := range wrap.GetAddresses() {
+		addr.MserviceId = wrap.GetMserviceId()
+		addr.PartyId = partyId
+
+		if addr.GetVersion() != 0 {
+			_, err = store.UpdateAddress(ctx, addr)
+		} else if wrap.GetPartyId() == 0 {
+			newAddrs = append(newAddrs, addr)
+		} else {
+			// may revive a deleted address of the same type
+			_, err = store.CreateAddress(ctx, addr)
+		}
+
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	err = store.CreateAddresses(ctx, newAddrs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var newPhones []*pb.Phone
+
+	for _, phone := range wrap.GetPhones() {
+		phone.MserviceId = wrap.GetMserviceId()
+		phone.PartyId = partyId
+
+		if phone.GetVersion() != 0 {
+			_, err = store.UpdatePhone(ctx, phone)
+		} else if wrap.GetPartyId() == 0 {
+			newPhones = append(newPhones, phone)
+		} else {
+			// may revive a deleted phone of the same type
+			_, err = store.CreatePhone(ctx, phone)
+		}
+
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	err = store.CreatePhones(ctx, newPhones)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return partyId, version, nil
+}
+
+// create new party with its addresses and phones in one transaction
+func (s *addrService) CreatePartyWrapper(ctx context.Context, req *pb.CreatePartyWrapperRequest) (*pb.CreatePartyWrapperResponse, error) {
+	resp := &pb.CreatePartyWrapperResponse{}
+
+	wrap := &pb.PartyWrapper{}
+	wrap.MserviceId = req.GetMserviceId()
+	wrap.PartyType = req.GetPartyType()
+	wrap.LastName = req.GetLastName()
+	wrap.MiddleName = req.GetMiddleName()
+	wrap.FirstName = req.GetFirstName()
+	wrap.Nickname = req.GetNickname()
+	wrap.Company = req.GetCompany()
+	wrap.Email = req.GetEmail()
+	wrap.Addresses = req.GetAddresses()
+	wrap.Phones = req.GetPhones()
+
+	// validate all inputs
+	invalidFields := validatePartyWrapper(wrap)
+
+	if len(invalidFields) > 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = fmt.Sprintf("invalid fields: %s", strings.Join(invalidFields, ","))
+		return resp, nil
+	}
+
+	partyId, err := s.createPartyWrapper(ctx, wrap)
+
+	if err == addrstore.ErrConflict {
+		resp.ErrorCode = 409
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	} else if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "CreatePartyWrapper", "error", err)
+		return resp, nil
+	}
+
+	wrap.PartyId = partyId
+	wrap.Version = 1
+	wrap.PartyTypeName = partyTypeMap[wrap.PartyType]
+
+	for _, addr := range wrap.Addresses {
+		addr.Version = 1
+		addr.AddressTypeName = addrTypeMap[addr.AddressType]
+	}
+
+	for _, phone := range wrap.Phones {
+		phone.Version = 1
+		phone.PhoneTypeName = phoneTypeMap[phone.PhoneType]
+	}
+
+	resp.PartyId = partyId
+	resp.Version = 1
+	resp.PartyWrapper = wrap
+
+	return resp, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Rows purged from each table per store call when no batch size is set.
+const defaultPurgeBatchSize = 500
+
+// permanently remove parties, addresses and phones deleted longer ago than the retention window
+func (s *addrService) PurgeDeleted(ctx context.Context, req *pb.PurgeDeletedRequest) (*pb.PurgeDeletedResponse, error) {
+	resp := &pb.PurgeDeletedResponse{}
+
+	days := int(req.GetOlderThanDays())
+	if (days == 0) || ((days > 0) && (days < s.purgeAfterDays)) {
+		// never purge inside the configured retention window
+		days = s.purgeAfterDays
+	}
+
+	if days <= 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: older_than_days"
+		return resp, nil
+	}
+
+	counts, err := s.purgeDeleted(ctx, req.GetMserviceId(), days)
+
+	for _, count := range counts {
+		resp.PartyCount += int32(count.Parties)
+		resp.AddressCount += int32(count.Addresses)
+		resp.PhoneCount += int32(count.Phones)
+	}
+
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp, nil
+}
+
+// Start purging deleted records every interval in the background, if a retention window is set,
+// until ctx is done.
+func (s *addrService) StartPurger(ctx context.Context, interval time.Duration) {
+	if (s.purgeAfterDays <= 0) || (interval <= 0) {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			s.purgeDeleted(ctx, 0, s.purgeAfterDays)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Helper to purge records deleted more than days ago, for one mservice account or all if
+// mserviceId is 0, in batches until none are left. Logs and returns the rows removed by account,
+// including those removed before any error.
+func (s *addrService) purgeDeleted(ctx context.Context, mserviceId int64, days int) ([]*addrstore.PurgeCount, error) {
+	batchSize := s.purgeBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+
+	deletedBefore := time.Now().AddDate(0, 0, -days)
+
+	totals := make(map[int64]*addrstore.PurgeCount)
+	var order []int64
+
+	var err error
+
+	for {
+		var counts []*addrstore.PurgeCount
+		counts, err = s.store.PurgeDeleted(ctx, mserviceId, deletedBefore, batchSize)
+		if err != nil {
+			level.Error(s.logger).Log("what", "PurgeDeleted", "error", err)
+			break
+		}
+
+		var parties, addresses, phones int
+
+		for _, count := range counts {
+			total, ok := totals[count.MserviceId]
+			if !ok {
+				total = &addrstore.PurgeCount{MserviceId: count.MserviceId}
+				totals[count.MserviceId] = total
+				order = append(order, count.MserviceId)
+			}
+
+			total.Parties += count.Parties
+			total.Addresses += count.Addresses
+			total.Phones += count.Phones
+
+			parties += count.Parties
+			addresses += count.Addresses
+			phones += count.Phones
+		}
+
+		// a short batch from every table means nothing is left
+		if (parties < batchSize) && (addresses < batchSize) && (phones < batchSize) {
+			break
+		}
+	}
+
+	var purged []*addrstore.PurgeCount
+
+	for _, msid := range order {
+		total := totals[msid]
+		level.Info(s.logger).Log("what", "purgeDeleted", "mservice_id", msid, "days", days,
+			"parties", total.Parties, "addresses", total.Addresses, "phones", total.Phones)
+		purged = append(purged, total)
+	}
+
+	return purged, err
+}
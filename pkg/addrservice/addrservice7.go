@@ -0,0 +1,224 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Actions of a HistoryEntry.
+const (
+	historyCreate   = "create"
+	historyUpdate   = "update"
+	historyDelete   = "delete"
+	historyRestore  = "restore"
+	historySnapshot = "snapshot"
+)
+
+// get the recorded versions of a party and its addresses and phones, oldest first
+func (s *addrService) GetPartyHistory(ctx context.Context, req *pb.GetPartyHistoryRequest) (*pb.GetPartyHistoryResponse, error) {
+	resp := &pb.GetPartyHistoryResponse{}
+
+	entries, err := s.store.GetPartyHistory(ctx, req.GetMserviceId(), req.GetPartyId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "GetPartyHistory", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if len(entries) == 0 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	}
+
+	type recordKey struct {
+		recordType string
+		childType  int32
+	}
+
+	previous := make(map[recordKey]*pb.HistoryEntry)
+
+	for _, entry := range entries {
+		key := recordKey{entry.GetRecordType(), entry.GetChildType()}
+		old := previous[key]
+		previous[key] = entry
+
+		if entry.NewParty != nil {
+			entry.NewParty.PartyTypeName = partyTypeMap[entry.NewParty.PartyType]
+		}
+
+		if entry.NewAddress != nil {
+			entry.NewAddress.AddressTypeName = addrTypeMap[entry.NewAddress.AddressType]
+		}
+
+		if entry.NewPhone != nil {
+			entry.NewPhone.PhoneTypeName = phoneTypeMap[entry.NewPhone.PhoneType]
+		}
+
+		entry.Action = historyAction(old, entry)
+
+		if old != nil {
+			entry.OldParty = old.NewParty
+			entry.OldAddress = old.NewAddress
+			entry.OldPhone = old.NewPhone
+		}
+	}
+
+	resp.Entries = entries
+
+	return resp, nil
+}
+
+// Helper to get the action that changed a record from its previous recorded version, nil if none.
+func historyAction(old *pb.HistoryEntry, entry *pb.HistoryEntry) string {
+	if old == nil {
+		if entry.GetVersion() == 1 {
+			return historyCreate
+		}
+
+		// the record existed before history was kept
+		return historySnapshot
+	}
+
+	oldDeleted, oldCreated := historyState(old)
+	newDeleted, newCreated := historyState(entry)
+
+	if !oldDeleted && newDeleted {
+		return historyDelete
+	}
+
+	if oldDeleted && !newDeleted {
+		// creating an address or phone in a deleted slot revives it with a new creation date
+		if newCreated != oldCreated {
+			return historyCreate
+		}
+
+		return historyRestore
+	}
+
+	return historyUpdate
+}
+
+// Helper to get the deleted flag and creation date of the record in a history entry.
+func historyState(entry *pb.HistoryEntry) (bool, int64) {
+	switch entry.GetRecordType() {
+	case addrstore.HistoryParty:
+		return entry.GetNewParty().GetIsDeleted(), entry.GetNewParty().GetCreated().GetMilliseconds()
+	case addrstore.HistoryAddress:
+		return entry.GetNewAddress().GetIsDeleted(), entry.GetNewAddress().GetCreated().GetMilliseconds()
+	}
+
+	return entry.GetNewPhone().GetIsDeleted(), entry.GetNewPhone().GetCreated().GetMilliseconds()
+}
+
+// Helper to reconstruct a party wrapper as it was at the as_of time of the request, from the last
+// recorded version of the party and of each address and phone at or before that time. Records that
+// existed before history was kept are only known from their snapshot, which stands in for a record
+// created by as_of but last changed after it, and the response is flagged approximate.
+func (s *addrService) getPartyWrapperAsOf(ctx context.Context, req *pb.GetPartyWrapperRequest) *pb.GetPartyWrapperResponse {
+	resp := &pb.GetPartyWrapperResponse{}
+
+	entries, err := s.store.GetPartyHistory(ctx, req.GetMserviceId(), req.GetPartyId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "GetPartyHistory", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	asOf := req.GetAsOf().GetMilliseconds()
+
+	type recordKey struct {
+		recordType string
+		childType  int32
+	}
+
+	recorded := make(map[recordKey]bool)
+
+	var party *pb.Party
+	addrs := make(map[int32]*pb.Address)
+	phones := make(map[int32]*pb.Phone)
+
+	// entries are in change order, so later versions replace earlier ones; the snapshots that start
+	// the history share change 0 but carry the modification dates of their records, so they are not
+	// in time order, and each entry is checked rather than stopping at the first one after as_of
+	for _, entry := range entries {
+		key := recordKey{entry.GetRecordType(), entry.GetChildType()}
+		first := !recorded[key]
+		recorded[key] = true
+
+		if entry.GetChanged().GetMilliseconds() > asOf {
+			// a snapshot after as_of of a live record created by then is the nearest version known
+			deleted, created := historyState(entry)
+			if !first || deleted || (created > asOf) {
+				continue
+			}
+
+			resp.Approximate = true
+		}
+
+		switch entry.GetRecordType() {
+		case addrstore.HistoryParty:
+			party = entry.GetNewParty()
+		case addrstore.HistoryAddress:
+			addrs[entry.GetChildType()] = entry.GetNewAddress()
+		case addrstore.HistoryPhone:
+			phones[entry.GetChildType()] = entry.GetNewPhone()
+		}
+	}
+
+	if (party == nil) || party.GetIsDeleted() {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp
+	}
+
+	wrap := convertPartyToWrapper(party)
+
+	for _, addr := range addrs {
+		if !addr.GetIsDeleted() {
+			addr.AddressTypeName = addrTypeMap[addr.AddressType]
+			wrap.Addresses = append(wrap.Addresses, addr)
+		}
+	}
+
+	sort.Slice(wrap.Addresses, func(i, j int) bool {
+		return wrap.Addresses[i].GetAddressType() < wrap.Addresses[j].GetAddressType()
+	})
+
+	for _, phone := range phones {
+		if !phone.GetIsDeleted() {
+			phone.PhoneTypeName = phoneTypeMap[phone.GetPhoneType()]
+			wrap.Phones = append(wrap.Phones, phone)
+		}
+	}
+
+	sort.Slice(wrap.Phones, func(i, j int) bool {
+		return wrap.Phones[i].GetPhoneType() < wrap.Phones[j].GetPhoneType()
+	})
+
+	resp.PartyWrapper = wrap
+
+	return resp
+}
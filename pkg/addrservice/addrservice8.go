@@ -0,0 +1,53 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/log/level"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Entries returned by QueryAuditLog when the request has no limit.
+const defaultAuditLimit = 1000
+
+// get the audit trail of calls to the service, most recent first
+func (s *addrService) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	resp := &pb.QueryAuditLogResponse{}
+
+	if req.GetLimit() < 0 {
+		resp.ErrorCode = 406
+		resp.ErrorMessage = "invalid fields: limit"
+		return resp, nil
+	}
+
+	if req.GetLimit() == 0 {
+		req.Limit = defaultAuditLimit
+	}
+
+	entries, err := s.store.QueryAuditLog(ctx, req)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryAuditLog", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	resp.Entries = entries
+
+	return resp, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// A versioned update or delete that matched no live record gets 412 "version conflict", with the current
+// version and record, if the record is live at another version; otherwise it gets 404 "not found".
+
+// Helper to get the live party at another version than a write expected, nil if none.
+func (s *addrService) conflictingParty(ctx context.Context, mserviceId int64, partyId int64, version int32) *pb.Party {
+	party, err := s.store.GetParty(ctx, mserviceId, partyId)
+	if (err != nil) || (party.GetVersion() == version) {
+		return nil
+	}
+
+	party.PartyTypeName = partyTypeMap[party.PartyType]
+
+	return party
+}
+
+// Helper to get the live address at another version than a write expected, nil if none.
+func (s *addrService) conflictingAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32,
+	version int32) *pb.Address {
+	addr, err := s.store.GetAddress(ctx, mserviceId, partyId, addressType)
+	if (err != nil) || (addr.GetVersion() == version) {
+		return nil
+	}
+
+	addr.AddressTypeName = addrTypeMap[addr.AddressType]
+
+	return addr
+}
+
+// Helper to get the live phone at another version than a write expected, nil if none.
+func (s *addrService) conflictingPhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32,
+	version int32) *pb.Phone {
+	phone, err := s.store.GetPhone(ctx, mserviceId, partyId, phoneType)
+	if (err != nil) || (phone.GetVersion() == version) {
+		return nil
+	}
+
+	phone.PhoneTypeName = phoneTypeMap[phone.PhoneType]
+
+	return phone
+}
+
+// A restore that matched no deleted record gets 412 "version conflict", with the current version and
+// record, if the record is live, or deleted at another version; otherwise it gets 404 "not found".
+// A deleted record is only read from its last recorded version in the history.
+
+// Helper to get the last recorded version of a party, address or phone in its history, nil if none.
+func (s *addrService) lastRecorded(ctx context.Context, mserviceId int64, partyId int64, recordType string,
+	childType int32) *pb.HistoryEntry {
+	entries, err := s.store.GetPartyHistory(ctx, mserviceId, partyId)
+	if err != nil {
+		return nil
+	}
+
+	var last *pb.HistoryEntry
+
+	for _, entry := range entries {
+		if (entry.GetRecordType() == recordType) && (entry.GetChildType() == childType) &&
+			(entry.GetVersion() > last.GetVersion()) {
+			last = entry
+		}
+	}
+
+	return last
+}
+
+// Helper to get the party a restore did not match, nil if none.
+func (s *addrService) unrestorableParty(ctx context.Context, mserviceId int64, partyId int64, version int32) *pb.Party {
+	party, err := s.store.GetParty(ctx, mserviceId, partyId)
+	if err != nil {
+		party = s.lastRecorded(ctx, mserviceId, partyId, addrstore.HistoryParty, 0).GetNewParty()
+		if (party == nil) || (party.GetVersion() == version) {
+			return nil
+		}
+	}
+
+	party.PartyTypeName = partyTypeMap[party.PartyType]
+
+	return party
+}
+
+// Helper to get the address a restore did not match, nil if none.
+func (s *addrService) unrestorableAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32,
+	version int32) *pb.Address {
+	addr, err := s.store.GetAddress(ctx, mserviceId, partyId, addressType)
+	if err != nil {
+		addr = s.lastRecorded(ctx, mserviceId, partyId, addrstore.HistoryAddress, addressType).GetNewAddress()
+		if (addr == nil) || (addr.GetVersion() == version) {
+			return nil
+		}
+	}
+
+	addr.AddressTypeName = addrTypeMap[addr.AddressType]
+
+	return addr
+}
+
+// Helper to get the phone a restore did not match, nil if none.
+func (s *addrService) unrestorablePhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32,
+	version int32) *pb.Phone {
+	phone, err := s.store.GetPhone(ctx, mserviceId, partyId, phoneType)
+	if err != nil {
+		phone = s.lastRecorded(ctx, mserviceId, partyId, addrstore.HistoryPhone, phoneType).GetNewPhone()
+		if (phone == nil) || (phone.GetVersion() == version) {
+			return nil
+		}
+	}
+
+	phone.PhoneTypeName = phoneTypeMap[phone.PhoneType]
+
+	return phone
+}
@@ -14,13 +14,16 @@
 package addrservice
 
 import (
-	"database/sql"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"regexp"
+	"strings"
 
+	"github.com/gaterace/addrbook/pkg/addrstore"
 	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
-	"github.com/gaterace/dml-go/pkg/dml"
 	"github.com/go-kit/kit/log/level"
-	_ "github.com/go-sql-driver/mysql"
 )
 
 var validName = regexp.MustCompile("^[-A-Za-z]{1,50}$")
@@ -34,54 +37,119 @@ var validPostalCode = regexp.MustCompile("^[-a-zA-Z0-9]{5,20}$")
 var validCountryCode = regexp.MustCompile("^[a-z][a-z]$")
 var validPhone = regexp.MustCompile("^(\\+[1-9][0-9]{0,3}-)?[1-9][0-9]{2}-[1-9][0-9]{2}-[0-9]{4}(x[0-9]+)?$")
 
+// Imported country names accepted for the us country code.
+var usCountryNames = map[string]bool{
+	"":                         true,
+	"us":                       true,
+	"usa":                      true,
+	"united states":            true,
+	"united states of america": true,
+}
+
+// Returned within a transaction when the party for an address or phone is missing or deleted.
+var errPartyNotFound = errors.New("party not found")
+
+// Largest page returned by get_parties.
+const maxPageSize = 1000
+
+// Number of parties read from the store at a time by stream_party_wrappers.
+const streamBatchSize = 500
+
+// Contents of the opaque page token for get_parties.
+type pageToken struct {
+	OrderBy string `json:"o"`
+	Value   string `json:"v,omitempty"`
+	PartyId int64  `json:"id"`
+}
+
 // Generic response to set specific API method response.
 type genericResponse struct {
 	ErrorCode    int32
 	ErrorMessage string
 }
 
-func (s *addrService) GetPartyHelper(mserviceId int64, partyId int64) (*genericResponse, *pb.Party) {
+func (s *addrService) GetPartyHelper(ctx context.Context, mserviceId int64, partyId int64) (*genericResponse, *pb.Party) {
 	resp := &genericResponse{}
 
-	sqlstring := `SELECT inbPartyId, dtmCreated, dtmModified, intVersion, inbMserviceId, intPartyType, chvLastName, 
-	chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail FROM tb_Party WHERE inbMserviceId = ? AND 
-	inbPartyId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	var created string
-	var modified string
-	var party pb.Party
-
-	err = stmt.QueryRow(partyId, mserviceId).Scan(&party.PartyId, &created, &modified, &party.Version, &party.MserviceId,
-		&party.PartyType, &party.LastName, &party.MiddleName, &party.FirstName, &party.Nickname, &party.Company,
-		&party.Email)
+	party, err := s.store.GetParty(ctx, mserviceId, partyId)
 
 	if err == nil {
-		party.Created = dml.DateTimeFromString(created)
-		party.Modified = dml.DateTimeFromString(modified)
 		party.PartyTypeName = partyTypeMap[party.PartyType]
 		resp.ErrorCode = 0
-	} else if err == sql.ErrNoRows {
+	} else if err == addrstore.ErrNotFound {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "not found"
 
 	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		level.Error(s.logger).Log("what", "GetParty", "error", err)
 		resp.ErrorCode = 500
 		resp.ErrorMessage = err.Error()
 
 	}
 
-	return resp, &party
+	return resp, party
+}
+
+// Get the next batch of up to streamBatchSize party wrappers after a party identifier, using one query
+// each for the parties, addresses and phones.
+func (s *addrService) GetPartyWrapperBatch(ctx context.Context, mserviceId int64, afterPartyId int64) ([]*pb.PartyWrapper, error) {
+	return s.getPartyWrappers(ctx, mserviceId, afterPartyId, streamBatchSize)
+}
+
+// Helper to get up to limit party wrappers after a party identifier, in party identifier order.
+func (s *addrService) getPartyWrappers(ctx context.Context, mserviceId int64, afterPartyId int64, limit int) ([]*pb.PartyWrapper, error) {
+	after := &addrstore.PartyCursor{PartyId: afterPartyId}
+	parties, err := s.store.GetParties(ctx, mserviceId, addrstore.OrderByPartyId, after, limit)
+	if err != nil {
+		level.Error(s.logger).Log("what", "GetParties", "error", err)
+		return nil, err
+	}
+
+	if len(parties) == 0 {
+		return nil, nil
+	}
+
+	firstPartyId := parties[0].GetPartyId()
+	lastPartyId := parties[len(parties)-1].GetPartyId()
+
+	addrs, err := s.store.GetAddressesForParties(ctx, mserviceId, firstPartyId, lastPartyId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "GetAddressesForParties", "error", err)
+		return nil, err
+	}
+
+	phones, err := s.store.GetPhonesForParties(ctx, mserviceId, firstPartyId, lastPartyId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "GetPhonesForParties", "error", err)
+		return nil, err
+	}
+
+	wraps := make([]*pb.PartyWrapper, 0, len(parties))
+
+	// children are ordered by party identifier, like the parties
+	a, p := 0, 0
+	for _, party := range parties {
+		wrap := convertPartyToWrapper(party)
+		wrap.PartyTypeName = partyTypeMap[party.PartyType]
+
+		for ; (a < len(addrs)) && (addrs[a].GetPartyId() <= party.GetPartyId()); a++ {
+			if addrs[a].GetPartyId() == party.GetPartyId() {
+				addrs[a].AddressTypeName = addrTypeMap[addrs[a].AddressType]
+				wrap.Addresses = append(wrap.Addresses, addrs[a])
+			}
+		}
+
+		for ; (p < len(phones)) && (phones[p].GetPartyId() <= party.GetPartyId()); p++ {
+			if phones[p].GetPartyId() == party.GetPartyId() {
+				phones[p].PhoneTypeName = phoneTypeMap[phones[p].PhoneType]
+				wrap.Phones = append(wrap.Phones, phones[p])
+			}
+		}
+
+		wraps = append(wraps, wrap)
+	}
+
+	return wraps, nil
 }
 
 func convertPartyToWrapper(party *pb.Party) *pb.PartyWrapper {
@@ -102,6 +170,224 @@ func convertPartyToWrapper(party *pb.Party) *pb.PartyWrapper {
 	return &wrap
 }
 
+// Helper to convert a party wrapper to a party, without its addresses and phones.
+func convertWrapperToParty(wrap *pb.PartyWrapper) *pb.Party {
+	party := pb.Party{}
+	party.PartyId = wrap.GetPartyId()
+	party.Version = wrap.GetVersion()
+	party.MserviceId = wrap.GetMserviceId()
+	party.PartyType = wrap.GetPartyType()
+	party.LastName = wrap.GetLastName()
+	party.MiddleName = wrap.GetMiddleName()
+	party.FirstName = wrap.GetFirstName()
+	party.Nickname = wrap.GetNickname()
+	party.Company = wrap.GetCompany()
+	party.Email = wrap.GetEmail()
+
+	return &party
+}
+
+// Get the names of the invalid fields of a new party.
+func validateParty(party *pb.Party) []string {
+	var invalidFields []string
+
+	if _, ok := partyTypeMap[party.GetPartyType()]; !ok {
+		invalidFields = append(invalidFields, "party_type")
+	}
+
+	if !isValidName(party.GetLastName()) {
+		invalidFields = append(invalidFields, "last_name")
+	}
+
+	if !isValidName(party.GetFirstName()) {
+		invalidFields = append(invalidFields, "first_name")
+	}
+
+	if (party.GetMiddleName() != "") && !isValidName(party.GetMiddleName()) {
+		invalidFields = append(invalidFields, "middle_name")
+	}
+
+	if (party.GetNickname() != "") && !isValidName(party.GetNickname()) {
+		invalidFields = append(invalidFields, "nickname")
+	}
+
+	if (party.GetCompany() != "") && !isValidCompany(party.GetCompany()) {
+		invalidFields = append(invalidFields, "company")
+	}
+
+	if (party.GetCompany() == "") && (party.GetPartyType() == 2) {
+		invalidFields = append(invalidFields, "company")
+	}
+
+	if !isValidEmail(party.GetEmail()) {
+		invalidFields = append(invalidFields, "email")
+	}
+
+	return invalidFields
+}
+
+// Get the names of the invalid fields of a new address.
+func validateAddress(addr *pb.Address) []string {
+	var invalidFields []string
+
+	if _, ok := addrTypeMap[addr.GetAddressType()]; !ok {
+		invalidFields = append(invalidFields, "address_type")
+	}
+
+	if !isValidAddress(addr.GetAddress_1()) {
+		invalidFields = append(invalidFields, "address_1")
+	}
+
+	if (addr.GetAddress_2() != "") && !isValidAddress(addr.GetAddress_2()) {
+		invalidFields = append(invalidFields, "address_2")
+	}
+
+	if !isValidCity(addr.GetCity()) {
+		invalidFields = append(invalidFields, "city")
+	}
+
+	if !isValidState(addr.GetState()) {
+		invalidFields = append(invalidFields, "state")
+	}
+
+	if !isValidPostalCode(addr.GetPostalCode(), addr.GetCountryCode()) {
+		invalidFields = append(invalidFields, "postal_code")
+	}
+
+	if !isValidCountryCode(addr.GetCountryCode()) {
+		invalidFields = append(invalidFields, "country_code")
+	}
+
+	return invalidFields
+}
+
+// Get the names of the invalid fields of a new phone.
+func validatePhone(phone *pb.Phone) []string {
+	var invalidFields []string
+
+	if _, ok := phoneTypeMap[phone.GetPhoneType()]; !ok {
+		invalidFields = append(invalidFields, "phone_type")
+	}
+
+	if !isValidPhone(phone.GetPhoneNumber()) {
+		invalidFields = append(invalidFields, "phone_number")
+	}
+
+	return invalidFields
+}
+
+// Get the names of the invalid fields of a new party wrapper, with child fields
+// prefixed by type, as in home_address.city or cell_phone.phone_number, and addresses or
+// phones if a type is repeated.
+func validatePartyWrapper(wrap *pb.PartyWrapper) []string {
+	invalidFields := validateParty(convertWrapperToParty(wrap))
+
+	for _, addr := range wrap.GetAddresses() {
+		for _, field := range validateAddress(addr) {
+			invalidFields = append(invalidFields, addrTypeMap[addr.GetAddressType()]+"_address."+field)
+		}
+	}
+
+	addrTypes := make(map[int32]bool)
+	for _, addr := range wrap.GetAddresses() {
+		if addrTypes[addr.GetAddressType()] {
+			invalidFields = append(invalidFields, "addresses")
+			break
+		}
+		addrTypes[addr.GetAddressType()] = true
+	}
+
+	for _, phone := range wrap.GetPhones() {
+		for _, field := range validatePhone(phone) {
+			invalidFields = append(invalidFields, phoneTypeMap[phone.GetPhoneType()]+"_phone."+field)
+		}
+	}
+
+	phoneTypes := make(map[int32]bool)
+	for _, phone := range wrap.GetPhones() {
+		if phoneTypes[phone.GetPhoneType()] {
+			invalidFields = append(invalidFields, "phones")
+			break
+		}
+		phoneTypes[phone.GetPhoneType()] = true
+	}
+
+	return invalidFields
+}
+
+// Context key for the user making a request.
+type actingUserKey struct{}
+
+// Get a context recording user, from the JWT, as the user making the request, for the history.
+func WithActingUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, actingUserKey{}, user)
+}
+
+// Helper to get the user making the request, or empty if not known.
+func actingUser(ctx context.Context) string {
+	user, _ := ctx.Value(actingUserKey{}).(string)
+	return user
+}
+
+// Helper to record the changes to a party and its addresses and phones in the history, as made by
+// the acting user; called last in the transaction making the changes.
+func recordHistory(ctx context.Context, tx addrstore.Store, mserviceId int64, partyId int64) error {
+	return tx.RecordHistory(ctx, mserviceId, partyId, actingUser(ctx))
+}
+
+// Helper to check that a party exists and is not deleted, returning errPartyNotFound if not.
+func requireLiveParty(ctx context.Context, store addrstore.Store, mserviceId int64, partyId int64) error {
+	_, err := store.GetParty(ctx, mserviceId, partyId)
+	if err == addrstore.ErrNotFound {
+		return errPartyNotFound
+	}
+
+	return err
+}
+
+// Create a party with its addresses and phones in one transaction, returning the party
+// identifier; nothing is created if any insert fails.
+func (s *addrService) createPartyWrapper(ctx context.Context, wrap *pb.PartyWrapper) (int64, error) {
+	var partyId int64
+
+	err := s.store.InTransaction(ctx, func(tx addrstore.Store) error {
+		var err error
+		partyId, err = tx.CreateParty(ctx, convertWrapperToParty(wrap))
+		if err != nil {
+			return err
+		}
+
+		for _, addr := range wrap.GetAddresses() {
+			addr.MserviceId = wrap.GetMserviceId()
+			addr.PartyId = partyId
+		}
+
+		err = tx.CreateAddresses(ctx, wrap.GetAddresses())
+		if err != nil {
+			return err
+		}
+
+		for _, phone := range wrap.GetPhones() {
+			phone.MserviceId = wrap.GetMserviceId()
+			phone.PartyId = partyId
+		}
+
+		err = tx.CreatePhones(ctx, wrap.GetPhones())
+		if err != nil {
+			return err
+		}
+
+		return recordHistory(ctx, tx, wrap.GetMserviceId(), partyId)
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "createPartyWrapper", "error", err)
+		return 0, err
+	}
+
+	return partyId, nil
+}
+
 func isValidName(name string) bool {
 	return validName.MatchString(name)
 }
@@ -149,3 +435,68 @@ func isValidCountryCode(name string) bool {
 func isValidPhone(phone string) bool {
 	return validPhone.MatchString(phone)
 }
+
+// Helper to encode the position after the last party in a page as an opaque token.
+func encodePageToken(orderBy string, cursor *addrstore.PartyCursor) string {
+	data, _ := json.Marshal(&pageToken{OrderBy: orderBy, Value: cursor.Value, PartyId: cursor.PartyId})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Helper to decode a page token into its sort order and cursor.
+func decodePageToken(token string) (string, *addrstore.PartyCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var pt pageToken
+	err = json.Unmarshal(data, &pt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return pt.OrderBy, &addrstore.PartyCursor{Value: pt.Value, PartyId: pt.PartyId}, nil
+}
+
+// Helper to lower case an imported country code, defaulting to us.
+func normalizeCountryCode(country string) string {
+	country = strings.ToLower(strings.TrimSpace(country))
+	if usCountryNames[country] {
+		return "us"
+	}
+
+	return country
+}
+
+// Helper to format imported North American numbers as ddd-ddd-dddd or +1-ddd-ddd-dddd,
+// keeping any extension; other numbers are returned unchanged.
+func normalizePhone(number string) string {
+	main := number
+	var ext string
+	if pos := strings.IndexAny(number, "xX;"); pos >= 0 {
+		main = number[:pos]
+		ext = strings.TrimLeft(number[pos+1:], "ext=. ")
+	}
+
+	var digits []byte
+	for i := 0; i < len(main); i++ {
+		if (main[i] >= '0') && (main[i] <= '9') {
+			digits = append(digits, main[i])
+		}
+	}
+
+	var result string
+	if len(digits) == 10 {
+		result = string(digits[0:3]) + "-" + string(digits[3:6]) + "-" + string(digits[6:10])
+	} else if (len(digits) == 11) && (digits[0] == '1') {
+		result = "+1-" + string(digits[1:4]) + "-" + string(digits[4:7]) + "-" + string(digits[7:11])
+	} else {
+		return number
+	}
+
+	if ext != "" {
+		result += "x" + ext
+	}
+
+	return result
+}
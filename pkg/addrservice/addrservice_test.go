@@ -0,0 +1,1215 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"github.com/go-kit/kit/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/gaterace/addrbook/pkg/addrstore"
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// mservice account used by the tests
+const testMserviceId = 23
+
+// Get a service over an empty MemoryStore.
+func newTestService(t *testing.T) (*addrService, *addrstore.MemoryStore) {
+	t.Helper()
+
+	store := addrstore.NewMemoryStore()
+
+	svc := NewAddrService()
+	svc.SetLogger(log.NewNopLogger())
+	svc.SetStore(store)
+
+	return svc, store
+}
+
+// Create a person in the test account, failing the test on any error.
+func createTestParty(t *testing.T, svc *addrService, lastName string, email string) int64 {
+	t.Helper()
+
+	resp, err := svc.CreateParty(context.Background(), &pb.CreatePartyRequest{MserviceId: testMserviceId,
+		PartyType: 1, FirstName: "Frodo", LastName: lastName, Email: email})
+	if (err != nil) || (resp.GetErrorCode() != 0) {
+		t.Fatalf("CreateParty: %v %d %s", err, resp.GetErrorCode(), resp.GetErrorMessage())
+	}
+
+	return resp.GetPartyId()
+}
+
+func TestPartyLifecycle(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+
+	got, _ := svc.GetParty(ctx, &pb.GetPartyRequest{MserviceId: testMserviceId, PartyId: partyId})
+	if (got.GetErrorCode() != 0) || (got.GetParty().GetEmail() != "frodo@baggins.org") || (got.GetParty().GetVersion() != 1) {
+		t.Fatalf("GetParty after create: %v", got)
+	}
+
+	upd, _ := svc.UpdateParty(ctx, &pb.UpdatePartyRequest{MserviceId: testMserviceId, PartyId: partyId, Version: 1,
+		PartyType: 1, FirstName: "Frodo", LastName: "Baggins", Email: "frodo@bagend.org"})
+	if (upd.GetErrorCode() != 0) || (upd.GetVersion() != 2) {
+		t.Fatalf("UpdateParty: %v", upd)
+	}
+
+	stale, _ := svc.UpdateParty(ctx, &pb.UpdatePartyRequest{MserviceId: testMserviceId, PartyId: partyId, Version: 1,
+		PartyType: 1, FirstName: "Frodo", LastName: "Baggins", Email: "frodo@shire.org"})
+	if (stale.GetErrorCode() != 412) || (stale.GetCurrentVersion() != 2) ||
+		(stale.GetCurrentParty().GetEmail() != "frodo@bagend.org") {
+		t.Fatalf("UpdateParty with a stale version: %v", stale)
+	}
+
+	got, _ = svc.GetParty(ctx, &pb.GetPartyRequest{MserviceId: testMserviceId, PartyId: partyId})
+	if got.GetParty().GetEmail() != "frodo@bagend.org" {
+		t.Fatalf("stale update changed the party: %v", got.GetParty())
+	}
+
+	del, _ := svc.DeleteParty(ctx, &pb.DeletePartyRequest{MserviceId: testMserviceId, PartyId: partyId, Version: 2})
+	if (del.GetErrorCode() != 0) || (del.GetVersion() != 3) {
+		t.Fatalf("DeleteParty: %v", del)
+	}
+
+	got, _ = svc.GetParty(ctx, &pb.GetPartyRequest{MserviceId: testMserviceId, PartyId: partyId})
+	if got.GetErrorCode() != 404 {
+		t.Fatalf("GetParty after delete: %v", got)
+	}
+}
+
+func TestCreatePartyInvalidFields(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	resp, _ := svc.CreateParty(context.Background(), &pb.CreatePartyRequest{MserviceId: testMserviceId,
+		PartyType: 2, FirstName: "Frodo", LastName: "Baggins", Email: "not an email"})
+	if (resp.GetErrorCode() != 406) || (resp.GetErrorMessage() != "invalid fields: company,email") {
+		t.Fatalf("CreateParty: %d %s", resp.GetErrorCode(), resp.GetErrorMessage())
+	}
+}
+
+func TestUpdatePartyInvalidFields(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+
+	resp, _ := svc.UpdateParty(context.Background(), &pb.UpdatePartyRequest{MserviceId: testMserviceId,
+		PartyId: partyId, Version: 1, PartyType: 1, FirstName: "Frodo", Email: "not an email"})
+	if (resp.GetErrorCode() != 406) || (resp.GetErrorMessage() != "invalid fields: last_name,email") {
+		t.Fatalf("UpdateParty: %d %s", resp.GetErrorCode(), resp.GetErrorMessage())
+	}
+}
+
+func TestMserviceScoping(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+
+	got, _ := svc.GetParty(ctx, &pb.GetPartyRequest{MserviceId: testMserviceId + 1, PartyId: partyId})
+	if got.GetErrorCode() != 404 {
+		t.Fatalf("GetParty from another account: %v", got)
+	}
+
+	del, _ := svc.DeleteParty(ctx, &pb.DeletePartyRequest{MserviceId: testMserviceId + 1, PartyId: partyId, Version: 1})
+	if del.GetErrorCode() != 404 {
+		t.Fatalf("DeleteParty from another account: %v", del)
+	}
+
+	list, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId + 1})
+	if len(list.GetParties()) != 0 {
+		t.Fatalf("GetParties from another account: %v", list.GetParties())
+	}
+}
+
+func TestAddressAndPhoneVersions(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+
+	addr, _ := svc.CreateAddress(ctx, &pb.CreateAddressRequest{MserviceId: testMserviceId, PartyId: partyId,
+		AddressType: 1, Address_1: "1 Bag End", City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"})
+	if (addr.GetErrorCode() != 0) || (addr.GetVersion() != 1) {
+		t.Fatalf("CreateAddress: %v", addr)
+	}
+
+	updAddr, _ := svc.UpdateAddress(ctx, &pb.UpdateAddressRequest{MserviceId: testMserviceId, PartyId: partyId,
+		AddressType: 1, Version: 1, Address_1: "1 Bag End", City: "Bywater", State: "WA", PostalCode: "98000",
+		CountryCode: "us"})
+	if (updAddr.GetErrorCode() != 0) || (updAddr.GetVersion() != 2) {
+		t.Fatalf("UpdateAddress: %v", updAddr)
+	}
+
+	phone, _ := svc.CreatePhone(ctx, &pb.CreatePhoneRequest{MserviceId: testMserviceId, PartyId: partyId,
+		PhoneType: 3, PhoneNumber: "543-555-1111"})
+	if (phone.GetErrorCode() != 0) || (phone.GetVersion() != 1) {
+		t.Fatalf("CreatePhone: %v", phone)
+	}
+
+	delPhone, _ := svc.DeletePhone(ctx, &pb.DeletePhoneRequest{MserviceId: testMserviceId, PartyId: partyId,
+		PhoneType: 3, Version: 1})
+	if (delPhone.GetErrorCode() != 0) || (delPhone.GetVersion() != 2) {
+		t.Fatalf("DeletePhone: %v", delPhone)
+	}
+
+	gotPhone, _ := svc.GetPhone(ctx, &pb.GetPhoneRequest{MserviceId: testMserviceId, PartyId: partyId, PhoneType: 3})
+	if gotPhone.GetErrorCode() != 404 {
+		t.Fatalf("GetPhone after delete: %v", gotPhone)
+	}
+
+	missing, _ := svc.UpdateAddress(ctx, &pb.UpdateAddressRequest{MserviceId: testMserviceId, PartyId: partyId,
+		AddressType: 2, Version: 1, Address_1: "1 Bag End", City: "Bywater", State: "WA", PostalCode: "98000",
+		CountryCode: "us"})
+	if missing.GetErrorCode() != 404 {
+		t.Fatalf("UpdateAddress of a missing address: %v", missing)
+	}
+}
+
+func TestUpdateAddressInvalidFields(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, partyId)
+
+	// address lines are checked as addresses, as in CreateAddress
+	resp, _ := svc.UpdateAddress(context.Background(), &pb.UpdateAddressRequest{MserviceId: testMserviceId,
+		PartyId: partyId, AddressType: 1, Version: 1, Address_1: "1 Bag End", Address_2: "#2", City: "Hobbiton",
+		State: "WA", PostalCode: "98000", CountryCode: "us"})
+	if (resp.GetErrorCode() != 406) || (resp.GetErrorMessage() != "invalid fields: address_2") {
+		t.Fatalf("UpdateAddress: %d %s", resp.GetErrorCode(), resp.GetErrorMessage())
+	}
+}
+
+func TestUpdatePhoneInvalidFields(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, partyId)
+
+	resp, _ := svc.UpdatePhone(context.Background(), &pb.UpdatePhoneRequest{MserviceId: testMserviceId,
+		PartyId: partyId, PhoneType: 3, Version: 1, PhoneNumber: "call the post office"})
+	if (resp.GetErrorCode() != 406) || (resp.GetErrorMessage() != "invalid fields: phone_number") {
+		t.Fatalf("UpdatePhone: %d %s", resp.GetErrorCode(), resp.GetErrorMessage())
+	}
+}
+
+func TestPatchParty(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+
+	tests := []struct {
+		name      string
+		version   int32
+		paths     []string
+		errorCode int32
+	}{
+		{"email only", 1, []string{"email"}, 0},
+		{"stale version", 1, []string{"email"}, 412},
+		{"unknown field", 2, []string{"birthday"}, 406},
+		{"no fields", 2, nil, 406},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, _ := svc.PatchParty(ctx, &pb.PatchPartyRequest{MserviceId: testMserviceId, PartyId: partyId,
+				Version: test.version, Email: "frodo@bagend.org", UpdateMask: &fieldmaskpb.FieldMask{Paths: test.paths}})
+			if resp.GetErrorCode() != test.errorCode {
+				t.Fatalf("PatchParty: %v", resp)
+			}
+		})
+	}
+
+	// fields outside the mask are kept
+	got, _ := svc.GetParty(ctx, &pb.GetPartyRequest{MserviceId: testMserviceId, PartyId: partyId})
+	if (got.GetParty().GetEmail() != "frodo@bagend.org") || (got.GetParty().GetLastName() != "Baggins") ||
+		(got.GetParty().GetVersion() != 2) {
+		t.Fatalf("GetParty after PatchParty: %v", got.GetParty())
+	}
+}
+
+func TestUpdatePhoneVersionConflict(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, partyId)
+
+	upd, _ := svc.UpdatePhone(ctx, &pb.UpdatePhoneRequest{MserviceId: testMserviceId, PartyId: partyId, PhoneType: 3,
+		Version: 1, PhoneNumber: "543-555-2222"})
+	if (upd.GetErrorCode() != 0) || (upd.GetVersion() != 2) {
+		t.Fatalf("UpdatePhone: %v", upd)
+	}
+
+	stale, _ := svc.UpdatePhone(ctx, &pb.UpdatePhoneRequest{MserviceId: testMserviceId, PartyId: partyId, PhoneType: 3,
+		Version: 1, PhoneNumber: "543-555-3333"})
+	if (stale.GetErrorCode() != 412) || (stale.GetCurrentVersion() != 2) ||
+		(stale.GetCurrentPhone().GetPhoneNumber() != "543-555-2222") {
+		t.Fatalf("UpdatePhone with a stale version: %v", stale)
+	}
+
+	missing, _ := svc.UpdatePhone(ctx, &pb.UpdatePhoneRequest{MserviceId: testMserviceId, PartyId: partyId, PhoneType: 1,
+		Version: 1, PhoneNumber: "543-555-3333"})
+	if missing.GetErrorCode() != 404 {
+		t.Fatalf("UpdatePhone of a missing phone: %v", missing)
+	}
+}
+
+func TestSearchParties(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	frodoId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, frodoId)
+	samId := createTestParty(t, svc, "Gamgee", "sam@gamgee.org")
+
+	tests := []struct {
+		name   string
+		filter *pb.SearchPartiesRequest
+		want   []int64
+	}{
+		{"last name prefix", &pb.SearchPartiesRequest{LastName: "gam"}, []int64{samId}},
+		{"email", &pb.SearchPartiesRequest{Email: "FRODO@baggins.org"}, []int64{frodoId}},
+		{"phone number", &pb.SearchPartiesRequest{PhoneNumber: "543-555-1111"}, []int64{frodoId}},
+		{"city", &pb.SearchPartiesRequest{City: "Hobbiton"}, []int64{frodoId}},
+		{"all", &pb.SearchPartiesRequest{}, []int64{frodoId, samId}},
+		{"none", &pb.SearchPartiesRequest{Company: "Green Dragon"}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.filter.MserviceId = testMserviceId
+
+			resp, _ := svc.SearchParties(ctx, test.filter)
+			if (resp.GetErrorCode() != 0) || (len(resp.GetParties()) != len(test.want)) {
+				t.Fatalf("SearchParties: %v", resp)
+			}
+
+			for i, party := range resp.GetParties() {
+				if party.GetPartyId() != test.want[i] {
+					t.Fatalf("SearchParties: %v", resp.GetParties())
+				}
+			}
+		})
+	}
+}
+
+func TestCreatePartyWrapper(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	created, _ := svc.CreatePartyWrapper(ctx, &pb.CreatePartyWrapperRequest{MserviceId: testMserviceId, PartyType: 1,
+		FirstName: "Frodo", LastName: "Baggins", Email: "frodo@baggins.org",
+		Addresses: []*pb.Address{{AddressType: 1, Address_1: "1 Bag End", City: "Hobbiton", State: "WA",
+			PostalCode: "98000", CountryCode: "us"}},
+		Phones: []*pb.Phone{{PhoneType: 3, PhoneNumber: "543-555-1111"}}})
+	if (created.GetErrorCode() != 0) || (created.GetPartyId() == 0) {
+		t.Fatalf("CreatePartyWrapper: %v", created)
+	}
+
+	got, _ := svc.GetPartyWrapper(ctx, &pb.GetPartyWrapperRequest{MserviceId: testMserviceId,
+		PartyId: created.GetPartyId()})
+	wrap := got.GetPartyWrapper()
+	if (got.GetErrorCode() != 0) || (len(wrap.GetAddresses()) != 1) || (len(wrap.GetPhones()) != 1) ||
+		(wrap.GetPhones()[0].GetVersion() != 1) {
+		t.Fatalf("GetPartyWrapper: %v", got)
+	}
+
+	invalid, _ := svc.CreatePartyWrapper(ctx, &pb.CreatePartyWrapperRequest{MserviceId: testMserviceId, PartyType: 1,
+		FirstName: "Sam", LastName: "Gamgee", Email: "sam@gamgee.org",
+		Phones: []*pb.Phone{{PhoneType: 3, PhoneNumber: "543-555-2222"}, {PhoneType: 3, PhoneNumber: "543-555-3333"}}})
+	if invalid.GetErrorCode() != 406 {
+		t.Fatalf("CreatePartyWrapper with two cell phones: %v", invalid)
+	}
+
+	list, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId})
+	if len(list.GetParties()) != 1 {
+		t.Fatalf("parties after an invalid wrapper: %v", list.GetParties())
+	}
+}
+
+// Store whose CreatePhones fails with err, in and out of transactions.
+type failingPhonesStore struct {
+	addrstore.Store
+	err error
+}
+
+func (s *failingPhonesStore) InTransaction(ctx context.Context, fn func(tx addrstore.Store) error) error {
+	return s.Store.InTransaction(ctx, func(tx addrstore.Store) error {
+		return fn(&failingPhonesStore{Store: tx, err: s.err})
+	})
+}
+
+func (s *failingPhonesStore) CreatePhones(ctx context.Context, phones []*pb.Phone) error {
+	return s.err
+}
+
+func TestCreatePartyWrapperStoreErrors(t *testing.T) {
+	svc, store := newTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		err       error
+		errorCode int32
+	}{
+		{addrstore.ErrConflict, 409},
+		{errors.New("disk full"), 501},
+	}
+
+	for _, test := range tests {
+		svc.SetStore(&failingPhonesStore{Store: store, err: test.err})
+
+		resp, _ := svc.CreatePartyWrapper(ctx, &pb.CreatePartyWrapperRequest{MserviceId: testMserviceId,
+			PartyType: 1, FirstName: "Frodo", LastName: "Baggins", Email: "frodo@baggins.org",
+			Phones: []*pb.Phone{{PhoneType: 3, PhoneNumber: "543-555-1111"}}})
+		if (resp.GetErrorCode() != test.errorCode) || (resp.GetErrorMessage() != test.err.Error()) {
+			t.Errorf("CreatePartyWrapper with %v: %v", test.err, resp)
+		}
+	}
+
+	svc.SetStore(store)
+
+	list, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId})
+	if len(list.GetParties()) != 0 {
+		t.Fatalf("parties after failed wrappers: %v", list.GetParties())
+	}
+}
+
+func TestGetPartyHistoryActions(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+
+	svc.UpdateParty(ctx, &pb.UpdatePartyRequest{MserviceId: testMserviceId, PartyId: partyId, Version: 1,
+		PartyType: 1, FirstName: "Frodo", LastName: "Baggins", Email: "frodo@bagend.org"})
+	svc.DeleteParty(ctx, &pb.DeletePartyRequest{MserviceId: testMserviceId, PartyId: partyId, Version: 2})
+	svc.RestoreParty(ctx, &pb.RestorePartyRequest{MserviceId: testMserviceId, PartyId: partyId, Version: 3})
+
+	resp, _ := svc.GetPartyHistory(ctx, &pb.GetPartyHistoryRequest{MserviceId: testMserviceId, PartyId: partyId})
+
+	var actions []string
+	for _, entry := range resp.GetEntries() {
+		actions = append(actions, entry.GetAction())
+	}
+
+	if got := strings.Join(actions, ","); got != "create,update,delete,restore" {
+		t.Fatalf("GetPartyHistory actions: %s", got)
+	}
+
+	update := resp.GetEntries()[1]
+	if (update.GetOldParty().GetEmail() != "frodo@baggins.org") || (update.GetNewParty().GetEmail() != "frodo@bagend.org") {
+		t.Fatalf("GetPartyHistory update: %v", update)
+	}
+}
+
+func TestGetPartiesPageTokens(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	for _, lastName := range []string{"Took", "Baggins", "Gamgee"} {
+		createTestParty(t, svc, lastName, "frodo@baggins.org")
+	}
+
+	first, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId, OrderBy: "last_name", PageSize: 2})
+	if (first.GetErrorCode() != 0) || (len(first.GetParties()) != 2) || (first.GetNextPageToken() == "") {
+		t.Fatalf("GetParties first page: %v", first)
+	}
+
+	second, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId, OrderBy: "last_name", PageSize: 2,
+		PageToken: first.GetNextPageToken()})
+	if (second.GetErrorCode() != 0) || (len(second.GetParties()) != 1) || (second.GetNextPageToken() != "") ||
+		(second.GetParties()[0].GetLastName() != "Took") {
+		t.Fatalf("GetParties last page: %v", second)
+	}
+
+	// a token only continues the sort order it was issued for
+	other, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId, OrderBy: "created", PageSize: 2,
+		PageToken: first.GetNextPageToken()})
+	if (other.GetErrorCode() != 406) || (other.GetErrorMessage() != "invalid fields: page_token") {
+		t.Fatalf("GetParties with another order: %v", other)
+	}
+
+	garbled, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId, OrderBy: "last_name", PageSize: 2,
+		PageToken: "not a token"})
+	if garbled.GetErrorCode() != 406 {
+		t.Fatalf("GetParties with a garbled token: %v", garbled)
+	}
+}
+
+func TestExportPages(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	for _, lastName := range []string{"Baggins", "Gamgee", "Took"} {
+		createTestParty(t, svc, lastName, "frodo@baggins.org")
+	}
+
+	var cards int32
+	vReq := &pb.ExportVcardRequest{MserviceId: testMserviceId, PageSize: 2}
+	for pages := 1; ; pages++ {
+		vResp, _ := svc.ExportVcard(ctx, vReq)
+		if (vResp.GetErrorCode() != 0) || (pages > 2) {
+			t.Fatalf("ExportVcard page %d: %v", pages, vResp)
+		}
+
+		cards += vResp.GetCardCount()
+		if vResp.GetNextPageToken() == "" {
+			break
+		}
+		vReq.PageToken = vResp.GetNextPageToken()
+	}
+
+	if cards != 3 {
+		t.Fatalf("ExportVcard exported %d cards", cards)
+	}
+
+	first, _ := svc.ExportCsv(ctx, &pb.ExportCsvRequest{MserviceId: testMserviceId, PageSize: 2})
+	second, _ := svc.ExportCsv(ctx, &pb.ExportCsvRequest{MserviceId: testMserviceId, PageSize: 2,
+		PageToken: first.GetNextPageToken()})
+	if (first.GetRowCount() != 2) || (second.GetRowCount() != 1) || (second.GetNextPageToken() != "") {
+		t.Fatalf("ExportCsv pages: %v %v", first, second)
+	}
+
+	// the header is only on the first page
+	data := string(first.GetCsvData()) + string(second.GetCsvData())
+	if (strings.Count(data, "\n") != 4) || !strings.HasPrefix(data, "party_id,") ||
+		!strings.Contains(string(second.GetCsvData()), "Took") {
+		t.Fatalf("ExportCsv data: %s", data)
+	}
+
+	// a get_parties token for another sort order is not an export token
+	parties, _ := svc.GetParties(ctx, &pb.GetPartiesRequest{MserviceId: testMserviceId, OrderBy: "last_name", PageSize: 1})
+	bad, _ := svc.ExportCsv(ctx, &pb.ExportCsvRequest{MserviceId: testMserviceId, PageToken: parties.GetNextPageToken()})
+	if bad.GetErrorCode() != 406 {
+		t.Fatalf("ExportCsv with a last_name token: %v", bad)
+	}
+}
+
+// Create a home address and cell phone for a party, failing the test on any error.
+func createTestChildren(t *testing.T, svc *addrService, partyId int64) {
+	t.Helper()
+	ctx := context.Background()
+
+	addr, _ := svc.CreateAddress(ctx, &pb.CreateAddressRequest{MserviceId: testMserviceId, PartyId: partyId,
+		AddressType: 1, Address_1: "1 Bag End", City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"})
+	if addr.GetErrorCode() != 0 {
+		t.Fatalf("CreateAddress: %v", addr)
+	}
+
+	phone, _ := svc.CreatePhone(ctx, &pb.CreatePhoneRequest{MserviceId: testMserviceId, PartyId: partyId,
+		PhoneType: 3, PhoneNumber: "543-555-1111"})
+	if phone.GetErrorCode() != 0 {
+		t.Fatalf("CreatePhone: %v", phone)
+	}
+}
+
+func TestDeletePartyCascades(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, partyId)
+
+	del, _ := svc.DeleteParty(ctx, &pb.DeletePartyRequest{MserviceId: testMserviceId, PartyId: partyId, Version: 1})
+	if del.GetErrorCode() != 0 {
+		t.Fatalf("DeleteParty: %v", del)
+	}
+
+	addr, _ := svc.GetAddress(ctx, &pb.GetAddressRequest{MserviceId: testMserviceId, PartyId: partyId, AddressType: 1})
+	phone, _ := svc.GetPhone(ctx, &pb.GetPhoneRequest{MserviceId: testMserviceId, PartyId: partyId, PhoneType: 3})
+	if (addr.GetErrorCode() != 404) || (phone.GetErrorCode() != 404) {
+		t.Fatalf("children of a deleted party: %v %v", addr, phone)
+	}
+
+	// no children for deleted or missing parties
+	for _, id := range []int64{partyId, partyId + 100} {
+		created, _ := svc.CreatePhone(ctx, &pb.CreatePhoneRequest{MserviceId: testMserviceId, PartyId: id,
+			PhoneType: 1, PhoneNumber: "543-555-2222"})
+		if created.GetErrorCode() != 404 {
+			t.Fatalf("CreatePhone for party %d: %v", id, created)
+		}
+	}
+}
+
+func TestTrashAndRestore(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	frodoId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, frodoId)
+	samId := createTestParty(t, svc, "Gamgee", "sam@gamgee.org")
+	createTestChildren(t, svc, samId)
+
+	for _, id := range []int64{frodoId, samId} {
+		del, _ := svc.DeleteParty(ctx, &pb.DeletePartyRequest{MserviceId: testMserviceId, PartyId: id, Version: 1})
+		if (del.GetErrorCode() != 0) || (del.GetVersion() != 2) {
+			t.Fatalf("DeleteParty: %v", del)
+		}
+	}
+
+	trash, _ := svc.GetDeletedParties(ctx, &pb.GetDeletedPartiesRequest{MserviceId: testMserviceId})
+	if (trash.GetErrorCode() != 0) || (len(trash.GetParties()) != 2) {
+		t.Fatalf("GetDeletedParties: %v", trash)
+	}
+
+	stale, _ := svc.RestoreParty(ctx, &pb.RestorePartyRequest{MserviceId: testMserviceId, PartyId: frodoId, Version: 1})
+	if (stale.GetErrorCode() != 412) || (stale.GetCurrentVersion() != 2) || !stale.GetCurrentParty().GetIsDeleted() {
+		t.Fatalf("RestoreParty with a stale version: %v", stale)
+	}
+
+	// frodo comes back with his children, sam without
+	restored, _ := svc.RestoreParty(ctx, &pb.RestorePartyRequest{MserviceId: testMserviceId, PartyId: frodoId, Version: 2,
+		RestoreChildren: true})
+	if (restored.GetErrorCode() != 0) || (restored.GetVersion() != 3) {
+		t.Fatalf("RestoreParty with children: %v", restored)
+	}
+
+	restored, _ = svc.RestoreParty(ctx, &pb.RestorePartyRequest{MserviceId: testMserviceId, PartyId: samId, Version: 2})
+	if restored.GetErrorCode() != 0 {
+		t.Fatalf("RestoreParty: %v", restored)
+	}
+
+	again, _ := svc.RestoreParty(ctx, &pb.RestorePartyRequest{MserviceId: testMserviceId, PartyId: samId, Version: 2})
+	if (again.GetErrorCode() != 412) || (again.GetCurrentVersion() != 3) || again.GetCurrentParty().GetIsDeleted() {
+		t.Fatalf("RestoreParty of a live party: %v", again)
+	}
+
+	missing, _ := svc.RestoreParty(ctx, &pb.RestorePartyRequest{MserviceId: testMserviceId, PartyId: samId + 100,
+		Version: 2})
+	if missing.GetErrorCode() != 404 {
+		t.Fatalf("RestoreParty of a missing party: %v", missing)
+	}
+
+	stalePhone, _ := svc.RestorePhone(ctx, &pb.RestorePhoneRequest{MserviceId: testMserviceId, PartyId: samId,
+		PhoneType: 3, Version: 1})
+	if (stalePhone.GetErrorCode() != 412) || (stalePhone.GetCurrentVersion() != 2) ||
+		(stalePhone.GetCurrentPhone().GetPhoneNumber() == "") {
+		t.Fatalf("RestorePhone with a stale version: %v", stalePhone)
+	}
+
+	phone, _ := svc.GetPhone(ctx, &pb.GetPhoneRequest{MserviceId: testMserviceId, PartyId: frodoId, PhoneType: 3})
+	if (phone.GetErrorCode() != 0) || (phone.GetPhone().GetVersion() != 3) {
+		t.Fatalf("GetPhone of a restored child: %v", phone)
+	}
+
+	phone, _ = svc.GetPhone(ctx, &pb.GetPhoneRequest{MserviceId: testMserviceId, PartyId: samId, PhoneType: 3})
+	if phone.GetErrorCode() != 404 {
+		t.Fatalf("GetPhone of a child left deleted: %v", phone)
+	}
+
+	addr, _ := svc.RestoreAddress(ctx, &pb.RestoreAddressRequest{MserviceId: testMserviceId, PartyId: samId,
+		AddressType: 1, Version: 2})
+	if (addr.GetErrorCode() != 0) || (addr.GetVersion() != 3) {
+		t.Fatalf("RestoreAddress: %v", addr)
+	}
+
+	addr, _ = svc.RestoreAddress(ctx, &pb.RestoreAddressRequest{MserviceId: testMserviceId, PartyId: samId,
+		AddressType: 1, Version: 2})
+	if (addr.GetErrorCode() != 412) || (addr.GetCurrentVersion() != 3) || addr.GetCurrentAddress().GetIsDeleted() {
+		t.Fatalf("RestoreAddress of a live address: %v", addr)
+	}
+
+	trash, _ = svc.GetDeletedParties(ctx, &pb.GetDeletedPartiesRequest{MserviceId: testMserviceId})
+	if len(trash.GetParties()) != 0 {
+		t.Fatalf("GetDeletedParties after restore: %v", trash)
+	}
+}
+
+func TestBulkUpsertVersionConflict(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, partyId)
+
+	tests := []struct {
+		name         string
+		version      int32
+		phoneVersion int32
+		errorCode    int32
+		current      int32
+	}{
+		{"stale party", 2, 0, 412, 1},
+		{"stale phone", 1, 2, 412, 1},
+		{"current", 1, 1, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wrap := &pb.PartyWrapper{MserviceId: testMserviceId, PartyId: partyId, Version: test.version,
+				PartyType: 1, FirstName: "Frodo", LastName: "Baggins", Email: "frodo@bagend.org"}
+			if test.phoneVersion != 0 {
+				wrap.Phones = []*pb.Phone{{PhoneType: 3, Version: test.phoneVersion, PhoneNumber: "543-555-2222"}}
+			}
+
+			result := svc.bulkUpsertPartyWrapper(context.Background(), wrap)
+			if (result.GetErrorCode() != test.errorCode) || (result.GetCurrentVersion() != test.current) {
+				t.Fatalf("bulkUpsertPartyWrapper: %v", result)
+			}
+		})
+	}
+
+	missing := svc.bulkUpsertPartyWrapper(context.Background(), &pb.PartyWrapper{MserviceId: testMserviceId,
+		PartyId: partyId + 100, Version: 1, PartyType: 1, FirstName: "Frodo", LastName: "Baggins",
+		Email: "frodo@baggins.org"})
+	if missing.GetErrorCode() != 404 {
+		t.Fatalf("bulkUpsertPartyWrapper of a missing party: %v", missing)
+	}
+}
+
+func TestReviveDeletedChild(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	partyId := createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+	createTestChildren(t, svc, partyId)
+
+	req := &pb.CreateAddressRequest{MserviceId: testMserviceId, PartyId: partyId, AddressType: 1,
+		Address_1: "3 Bagshot Row", City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"}
+
+	live, _ := svc.CreateAddress(ctx, req)
+	if live.GetErrorCode() != 409 {
+		t.Fatalf("CreateAddress on a live address: %v", live)
+	}
+
+	del, _ := svc.DeleteAddress(ctx, &pb.DeleteAddressRequest{MserviceId: testMserviceId, PartyId: partyId,
+		AddressType: 1, Version: 1})
+	if del.GetErrorCode() != 0 {
+		t.Fatalf("DeleteAddress: %v", del)
+	}
+
+	revived, _ := svc.CreateAddress(ctx, req)
+	if (revived.GetErrorCode() != 0) || (revived.GetVersion() != 3) {
+		t.Fatalf("CreateAddress on a deleted address: %v", revived)
+	}
+
+	got, _ := svc.GetAddress(ctx, &pb.GetAddressRequest{MserviceId: testMserviceId, PartyId: partyId, AddressType: 1})
+	if got.GetAddress().GetAddress_1() != "3 Bagshot Row" {
+		t.Fatalf("GetAddress after revive: %v", got)
+	}
+}
+
+func TestGetPartyWrapperAsOfSeededHistory(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB, err := addrstore.OpenSqliteDatabase(filepath.Join(t.TempDir(), "addrbook.db"))
+	if err != nil {
+		t.Fatalf("OpenSqliteDatabase: %v", err)
+	}
+
+	store := addrstore.NewSqliteStore(sqlDB)
+	defer store.Close()
+
+	_, err = store.MigrateUp(ctx)
+	if err != nil {
+		t.Fatalf("MigrateUp: %v", err)
+	}
+
+	// go back to before history was kept, to seed it from records written then
+	for {
+		version, err := store.MigrateDown(ctx)
+		if err != nil {
+			t.Fatalf("MigrateDown: %v", err)
+		}
+		if version <= 5 {
+			break
+		}
+	}
+
+	// the home address was changed last, so its seeded history comes after the cell phone's in time
+	// but before it in change order
+	seed := []string{
+		`INSERT INTO tb_Party (inbPartyId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+		intPartyType, chvLastName, chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail) VALUES
+		(1, '2020-01-01 10:00:00', '2020-04-01 10:00:00', '2020-04-01 10:00:00', FALSE, 2, 23, 1, 'Baggins', '',
+		'Frodo', '', '', 'frodo@baggins.org')`,
+		`INSERT INTO tb_Address (inbPartyId, intAddressType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+		intVersion, inbMserviceId, chvAddress1, chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode) VALUES
+		(1, 1, '2020-01-01 10:00:00', '2020-06-01 10:00:00', '2020-06-01 10:00:00', FALSE, 2, 23, '1 Bag End', '',
+		'Hobbiton', 'WA', '98000', 'us')`,
+		`INSERT INTO tb_Phone (inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+		inbMserviceId, chvPhoneNumber) VALUES (1, 3, '2020-02-01 10:00:00', '2020-02-01 10:00:00',
+		'2020-02-01 10:00:00', FALSE, 1, 23, '543-555-1111')`,
+	}
+
+	for _, sqlstring := range seed {
+		if _, err = store.DB().ExecContext(ctx, sqlstring); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+
+	_, err = store.MigrateUp(ctx)
+	if err != nil {
+		t.Fatalf("MigrateUp: %v", err)
+	}
+
+	svc := NewAddrService()
+	svc.SetLogger(log.NewNopLogger())
+	svc.SetStore(store)
+
+	// recorded after the seeded history
+	phone, _ := svc.CreatePhone(ctx, &pb.CreatePhoneRequest{MserviceId: testMserviceId, PartyId: 1, PhoneType: 2,
+		PhoneNumber: "543-555-2222"})
+	if phone.GetErrorCode() != 0 {
+		t.Fatalf("CreatePhone: %v", phone)
+	}
+
+	// the party and home address, created before as_of but last changed after it, are given as their snapshots
+	tests := []struct {
+		asOf        time.Time
+		addrs       int
+		phones      int
+		approximate bool
+	}{
+		{time.Date(2020, 1, 15, 0, 0, 0, 0, time.Local), 1, 0, true},
+		{time.Date(2020, 3, 1, 0, 0, 0, 0, time.Local), 1, 1, true},
+		{time.Date(2020, 7, 1, 0, 0, 0, 0, time.Local), 1, 1, false},
+		{time.Now().Add(time.Hour), 1, 2, false},
+	}
+
+	for _, test := range tests {
+		resp, _ := svc.GetPartyWrapper(ctx, &pb.GetPartyWrapperRequest{MserviceId: testMserviceId, PartyId: 1,
+			AsOf: dml.DateTimeFromTime(test.asOf)})
+		wrap := resp.GetPartyWrapper()
+		if (resp.GetErrorCode() != 0) || (len(wrap.GetAddresses()) != test.addrs) ||
+			(len(wrap.GetPhones()) != test.phones) || (resp.GetApproximate() != test.approximate) {
+			t.Fatalf("GetPartyWrapper as of %v: %v", test.asOf, resp)
+		}
+	}
+
+	before, _ := svc.GetPartyWrapper(ctx, &pb.GetPartyWrapperRequest{MserviceId: testMserviceId, PartyId: 1,
+		AsOf: dml.DateTimeFromTime(time.Date(2019, 12, 1, 0, 0, 0, 0, time.Local))})
+	if before.GetErrorCode() != 404 {
+		t.Fatalf("GetPartyWrapper as of before the party was created: %v", before)
+	}
+}
+
+// Store that hides saved idempotency keys from the first lookup in a transaction, as when a concurrent
+// call with the same key saves its response after the lookup.
+type racingStore struct {
+	addrstore.Store
+	hidden *bool
+}
+
+func (s *racingStore) InTransaction(ctx context.Context, fn func(tx addrstore.Store) error) error {
+	return s.Store.InTransaction(ctx, func(tx addrstore.Store) error {
+		return fn(&racingStore{Store: tx, hidden: s.hidden})
+	})
+}
+
+func (s *racingStore) GetIdempotencyEntry(ctx context.Context, mserviceId int64, key string) (*addrstore.IdempotencyEntry, error) {
+	if !*s.hidden {
+		*s.hidden = true
+		return nil, addrstore.ErrNotFound
+	}
+
+	return s.Store.GetIdempotencyEntry(ctx, mserviceId, key)
+}
+
+func TestCreatePartyIdempotencyKey(t *testing.T) {
+	svc, store := newTestService(t)
+	svc.SetIdempotencyWindows(time.Hour, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", "frodo-1"))
+	req := &pb.CreatePartyRequest{MserviceId: testMserviceId, PartyType: 1, FirstName: "Frodo", LastName: "Baggins",
+		Email: "frodo@baggins.org"}
+
+	first, _ := svc.CreateParty(ctx, req)
+	if (first.GetErrorCode() != 0) || (first.GetPartyId() == 0) {
+		t.Fatalf("CreateParty: %v", first)
+	}
+
+	repeat, _ := svc.CreateParty(ctx, req)
+	if (repeat.GetErrorCode() != 0) || (repeat.GetPartyId() != first.GetPartyId()) {
+		t.Fatalf("CreateParty repeat: %v", repeat)
+	}
+
+	// the saved response is replayed when the key turns up only as the create saves it
+	hidden := false
+	svc.SetStore(&racingStore{Store: store, hidden: &hidden})
+
+	raced, _ := svc.CreateParty(ctx, req)
+	if (raced.GetErrorCode() != 0) || (raced.GetPartyId() != first.GetPartyId()) || !hidden {
+		t.Fatalf("CreateParty racing a repeat: %v", raced)
+	}
+
+	svc.SetStore(store)
+
+	other := proto.Clone(req).(*pb.CreatePartyRequest)
+	other.Email = "frodo@bagend.org"
+
+	reused, _ := svc.CreateParty(ctx, other)
+	if reused.GetErrorCode() != 409 {
+		t.Fatalf("CreateParty reusing the key: %v", reused)
+	}
+
+	list, _ := svc.GetParties(context.Background(), &pb.GetPartiesRequest{MserviceId: testMserviceId})
+	if len(list.GetParties()) != 1 {
+		t.Fatalf("parties after repeats: %v", list.GetParties())
+	}
+
+	invalid := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", "frodo 1"))
+
+	resp, _ := svc.CreateParty(invalid, req)
+	if (resp.GetErrorCode() != 406) || (resp.GetErrorMessage() != "invalid fields: idempotency_key") {
+		t.Fatalf("CreateParty with an invalid key: %v", resp)
+	}
+}
+
+// Server stream that keeps the messages sent on it.
+type sentPartyWrappers struct {
+	grpc.ServerStream
+	sent []*pb.StreamPartyWrappersResponse
+}
+
+func (s *sentPartyWrappers) Context() context.Context {
+	return context.Background()
+}
+
+func (s *sentPartyWrappers) Send(resp *pb.StreamPartyWrappersResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestStreamPartyWrappersBatches(t *testing.T) {
+	svc, store := newTestService(t)
+	ctx := context.Background()
+
+	// more than two batches, with children on some parties only, and a party of another account,
+	// a deleted party and a deleted phone left out
+	const count = 2*streamBatchSize + 17
+
+	addrs := make(map[int64]int)
+	phones := make(map[int64]int)
+	var partyIds []int64
+
+	for i := 0; i < count; i++ {
+		partyId, err := store.CreateParty(ctx, &pb.Party{MserviceId: testMserviceId, PartyType: 1, FirstName: "Frodo",
+			LastName: "Baggins", Email: "frodo@baggins.org"})
+		if err != nil {
+			t.Fatalf("CreateParty: %v", err)
+		}
+
+		if i%3 == 0 {
+			store.CreateAddress(ctx, &pb.Address{MserviceId: testMserviceId, PartyId: partyId, AddressType: 1,
+				Address_1: "1 Bag End", City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"})
+			addrs[partyId] = 1
+		}
+
+		if i%5 == 0 {
+			store.CreatePhone(ctx, &pb.Phone{MserviceId: testMserviceId, PartyId: partyId, PhoneType: 1,
+				PhoneNumber: "543-555-1111"})
+			store.CreatePhone(ctx, &pb.Phone{MserviceId: testMserviceId, PartyId: partyId, PhoneType: 3,
+				PhoneNumber: "543-555-2222"})
+			phones[partyId] = 2
+		}
+
+		if i == 7 {
+			store.DeleteParty(ctx, testMserviceId, partyId, 1)
+			continue
+		}
+
+		if i == 10 {
+			store.DeletePhone(ctx, testMserviceId, partyId, 3, 1)
+			phones[partyId] = 1
+		}
+
+		if i == streamBatchSize {
+			store.CreateParty(ctx, &pb.Party{MserviceId: testMserviceId + 1, PartyType: 1, FirstName: "Sam",
+				LastName: "Gamgee", Email: "sam@gamgee.org"})
+		}
+
+		partyIds = append(partyIds, partyId)
+	}
+
+	stream := &sentPartyWrappers{}
+	if err := svc.StreamPartyWrappers(&pb.StreamPartyWrappersRequest{MserviceId: testMserviceId}, stream); err != nil {
+		t.Fatalf("StreamPartyWrappers: %v", err)
+	}
+
+	if len(stream.sent) != len(partyIds) {
+		t.Fatalf("StreamPartyWrappers sent %d party wrappers, want %d", len(stream.sent), len(partyIds))
+	}
+
+	for i, resp := range stream.sent {
+		wrap := resp.GetPartyWrapper()
+		if (resp.GetErrorCode() != 0) || (wrap.GetPartyId() != partyIds[i]) {
+			t.Fatalf("party wrapper %d: %v", i, resp)
+		}
+
+		if (len(wrap.GetAddresses()) != addrs[wrap.GetPartyId()]) || (len(wrap.GetPhones()) != phones[wrap.GetPartyId()]) {
+			t.Fatalf("children of party wrapper %d: %v", i, wrap)
+		}
+
+		for _, phone := range wrap.GetPhones() {
+			if (phone.GetPartyId() != wrap.GetPartyId()) || (phone.GetPhoneTypeName() == "") {
+				t.Fatalf("phone of party wrapper %d: %v", i, phone)
+			}
+		}
+	}
+}
+
+// MemoryStore whose SearchParties fails for one email.
+type failingSearchStore struct {
+	*addrstore.MemoryStore
+	email string
+}
+
+func (s *failingSearchStore) SearchParties(ctx context.Context, filter *pb.SearchPartiesRequest) ([]*pb.Party, error) {
+	if filter.GetEmail() == s.email {
+		return nil, errors.New("search failed")
+	}
+
+	return s.MemoryStore.SearchParties(ctx, filter)
+}
+
+func TestImportCsvOutcomes(t *testing.T) {
+	svc, store := newTestService(t)
+	svc.SetStore(&failingSearchStore{MemoryStore: store, email: "sam@gamgee.org"})
+	ctx := context.Background()
+
+	createTestParty(t, svc, "Baggins", "frodo@baggins.org")
+
+	csvData := "party_type,first_name,last_name,email\n" +
+		"person,Bilbo,Baggins,bilbo@baggins.org\n" +
+		"person,Frodo,Baggins,frodo@baggins.org\n" +
+		"person,Meriadoc,,merry@brandybuck.org\n" +
+		"person,Samwise,Gamgee,sam@gamgee.org\n"
+
+	resp, err := svc.ImportCsv(ctx, &pb.ImportCsvRequest{MserviceId: testMserviceId, CsvData: []byte(csvData)})
+	if (err != nil) || (resp.GetErrorCode() != 0) {
+		t.Fatalf("ImportCsv: %v %v", err, resp)
+	}
+
+	if (resp.GetCreatedCount() != 1) || (resp.GetSkippedCount() != 1) || (resp.GetRejectedCount() != 1) ||
+		(resp.GetFailedCount() != 1) {
+		t.Fatalf("ImportCsv counts: %v", resp)
+	}
+
+	want := []string{"ImportCreated", "ImportSkippedDuplicate", "ImportRejected", "ImportFailed"}
+	for i, result := range resp.GetResults() {
+		if result.GetOutcomeName() != want[i] {
+			t.Errorf("row %d outcome_name: %s", result.GetRowNumber(), result.GetOutcomeName())
+		}
+	}
+}
+
+// Server stream that receives the given party wrappers and keeps the response.
+type receivedPartyWrappers struct {
+	grpc.ServerStream
+	wraps []*pb.PartyWrapper
+	resp  *pb.BulkUpsertPartyWrappersResponse
+}
+
+func (s *receivedPartyWrappers) Context() context.Context {
+	return context.Background()
+}
+
+func (s *receivedPartyWrappers) Recv() (*pb.PartyWrapper, error) {
+	if len(s.wraps) == 0 {
+		return nil, io.EOF
+	}
+
+	wrap := s.wraps[0]
+	s.wraps = s.wraps[1:]
+
+	return wrap, nil
+}
+
+func (s *receivedPartyWrappers) SendAndClose(resp *pb.BulkUpsertPartyWrappersResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestBulkUpsertFailedResults(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	stream := &receivedPartyWrappers{}
+	for i := 0; i < maxBulkUpsertResults+10; i++ {
+		wrap := &pb.PartyWrapper{MserviceId: testMserviceId, PartyType: 1, FirstName: "Frodo", LastName: "Baggins",
+			Email: fmt.Sprintf("frodo%d@baggins.org", i)}
+		if i%2 == 1 {
+			wrap.LastName = ""
+		}
+		stream.wraps = append(stream.wraps, wrap)
+	}
+
+	if err := svc.BulkUpsertPartyWrappers(stream); err != nil {
+		t.Fatalf("BulkUpsertPartyWrappers: %v", err)
+	}
+
+	resp := stream.resp
+	if (resp.GetCreatedCount() != 505) || (resp.GetFailedCount() != 505) || (len(resp.GetResults()) != 505) {
+		t.Fatalf("BulkUpsertPartyWrappers counts: %d %d %d", resp.GetCreatedCount(), resp.GetFailedCount(),
+			len(resp.GetResults()))
+	}
+
+	for i, result := range resp.GetResults() {
+		if (result.GetIndex() != int32(2*i+1)) || (result.GetErrorCode() != 406) {
+			t.Fatalf("result %d: %v", i, result)
+		}
+	}
+
+	stream.wraps = nil
+	for i := 0; i < maxBulkUpsertResults+10; i++ {
+		stream.wraps = append(stream.wraps, &pb.PartyWrapper{MserviceId: testMserviceId, PartyType: 1})
+	}
+
+	if err := svc.BulkUpsertPartyWrappers(stream); err != nil {
+		t.Fatalf("BulkUpsertPartyWrappers: %v", err)
+	}
+
+	if (stream.resp.GetFailedCount() != maxBulkUpsertResults+10) || (len(stream.resp.GetResults()) != maxBulkUpsertResults) {
+		t.Fatalf("BulkUpsertPartyWrappers of all failures: %d %d", stream.resp.GetFailedCount(),
+			len(stream.resp.GetResults()))
+	}
+}
+
+// Store that keeps the cutoff times of its PurgeDeleted calls, purging nothing.
+type purgeCutoffStore struct {
+	addrstore.Store
+	mu      sync.Mutex
+	cutoffs []time.Time
+}
+
+func (s *purgeCutoffStore) PurgeDeleted(ctx context.Context, mserviceId int64, deletedBefore time.Time, limit int) ([]*addrstore.PurgeCount, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cutoffs = append(s.cutoffs, deletedBefore)
+	return nil, nil
+}
+
+func (s *purgeCutoffStore) purges() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.cutoffs)
+}
+
+func TestPurgeDeletedRetentionWindow(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		purgeAfterDays int
+		olderThanDays  int32
+		errorCode      int32
+		days           int
+	}{
+		{30, 0, 0, 30},
+		{30, 1, 0, 30},
+		{30, 60, 0, 60},
+		{0, 5, 0, 5},
+		{0, 0, 406, 0},
+		{0, -1, 406, 0},
+	}
+
+	for _, test := range tests {
+		store := &purgeCutoffStore{}
+		svc.SetStore(store)
+		svc.SetPurgePolicy(test.purgeAfterDays, 0)
+
+		resp, _ := svc.PurgeDeleted(ctx, &pb.PurgeDeletedRequest{MserviceId: testMserviceId,
+			OlderThanDays: test.olderThanDays})
+		if resp.GetErrorCode() != test.errorCode {
+			t.Errorf("PurgeDeleted %d days with window %d: %v", test.olderThanDays, test.purgeAfterDays, resp)
+			continue
+		}
+
+		if test.errorCode != 0 {
+			continue
+		}
+
+		want := time.Now().AddDate(0, 0, -test.days)
+		if (len(store.cutoffs) != 1) || (want.Sub(store.cutoffs[0]) > time.Minute) ||
+			(store.cutoffs[0].Sub(want) > time.Minute) {
+			t.Errorf("PurgeDeleted %d days with window %d: cutoffs %v, want %v", test.olderThanDays,
+				test.purgeAfterDays, store.cutoffs, want)
+		}
+	}
+}
+
+func TestStartPurgerStops(t *testing.T) {
+	svc, _ := newTestService(t)
+	store := &purgeCutoffStore{}
+	svc.SetStore(store)
+	svc.SetPurgePolicy(30, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	svc.StartPurger(ctx, time.Millisecond)
+
+	for start := time.Now(); store.purges() < 3; {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("purger ran %d times", store.purges())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	stopped := store.purges()
+	time.Sleep(20 * time.Millisecond)
+
+	if store.purges() != stopped {
+		t.Fatalf("purger still runs after its context is done: %d then %d purges", stopped, store.purges())
+	}
+}
+
+func TestQueryAuditLogLimit(t *testing.T) {
+	svc, store := newTestService(t)
+	ctx := context.Background()
+
+	for i := 0; i < defaultAuditLimit+1; i++ {
+		if err := store.WriteAuditEntry(ctx, &pb.AuditEntry{MserviceId: testMserviceId, Rpc: "get_party"}); err != nil {
+			t.Fatalf("WriteAuditEntry: %v", err)
+		}
+	}
+
+	tests := []struct {
+		limit     int32
+		errorCode int32
+		entries   int
+	}{
+		{0, 0, defaultAuditLimit},
+		{10, 0, 10},
+		{defaultAuditLimit + 5, 0, defaultAuditLimit + 1},
+		{-1, 406, 0},
+	}
+
+	for _, test := range tests {
+		resp, _ := svc.QueryAuditLog(ctx, &pb.QueryAuditLogRequest{MserviceId: testMserviceId, Limit: test.limit})
+		if (resp.GetErrorCode() != test.errorCode) || (len(resp.GetEntries()) != test.entries) {
+			t.Errorf("QueryAuditLog with limit %d: %d %s, %d entries", test.limit, resp.GetErrorCode(),
+				resp.GetErrorMessage(), len(resp.GetEntries()))
+		}
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The addrstore package provides the persistent storage used by the MServiceAddrbook service.
+// All records are scoped by mservice account identifier, deletes are soft (bitIsDeleted), and
+// updates and deletes use optimistic concurrency on the record version.
+
+package addrstore
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Returned when the record does not exist, is deleted, or the version does not match.
+var ErrNotFound = errors.New("not found")
+
+// Returned when creating an address or phone of a type the party already has.
+var ErrConflict = errors.New("already exists")
+
+// Sort orders for GetParties; ties are always broken by party identifier.
+const (
+	OrderByPartyId  = ""
+	OrderByLastName = "last_name"
+	OrderByCompany  = "company"
+	OrderByCreated  = "created"
+	OrderByModified = "modified"
+)
+
+// Format of created and modified values in a PartyCursor.
+const cursorTimeFormat = "2006-01-02 15:04:05"
+
+// Position of the last party in a page, for keyset pagination with GetParties.
+type PartyCursor struct {
+	// value of the sort column, empty when ordered by party identifier
+	Value string
+	// party identifier
+	PartyId int64
+}
+
+// Check if the sort order is supported by GetParties.
+func IsValidPartyOrder(orderBy string) bool {
+	switch orderBy {
+	case OrderByPartyId, OrderByLastName, OrderByCompany, OrderByCreated, OrderByModified:
+		return true
+	}
+
+	return false
+}
+
+// Get the cursor positioned at a party for the sort order.
+func NewPartyCursor(orderBy string, party *pb.Party) *PartyCursor {
+	cursor := &PartyCursor{PartyId: party.GetPartyId()}
+
+	switch orderBy {
+	case OrderByLastName:
+		cursor.Value = party.GetLastName()
+	case OrderByCompany:
+		cursor.Value = party.GetCompany()
+	case OrderByCreated:
+		cursor.Value = formatCursorTime(party.GetCreated().TimeFromDateTime())
+	case OrderByModified:
+		cursor.Value = formatCursorTime(party.GetModified().TimeFromDateTime())
+	}
+
+	return cursor
+}
+
+// Helper to format a timestamp as stored in the DATETIME columns.
+func formatCursorTime(t time.Time) string {
+	return t.Format(cursorTimeFormat)
+}
+
+// Record types of a HistoryEntry.
+const (
+	HistoryParty   = "party"
+	HistoryAddress = "address"
+	HistoryPhone   = "phone"
+)
+
+// Helper to sort history entries by change sequence, then party before addresses before phones, then
+// by type and version.
+func sortHistory(entries []*pb.HistoryEntry) {
+	rank := map[string]int{HistoryParty: 0, HistoryAddress: 1, HistoryPhone: 2}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a := entries[i]
+		b := entries[j]
+		if a.GetSequence() != b.GetSequence() {
+			return a.GetSequence() < b.GetSequence()
+		}
+		if a.GetRecordType() != b.GetRecordType() {
+			return rank[a.GetRecordType()] < rank[b.GetRecordType()]
+		}
+		if a.GetChildType() != b.GetChildType() {
+			return a.GetChildType() < b.GetChildType()
+		}
+		return a.GetVersion() < b.GetVersion()
+	})
+}
+
+// Number of rows removed by PurgeDeleted for one mservice account.
+type PurgeCount struct {
+	MserviceId int64
+	Parties    int
+	Addresses  int
+	Phones     int
+}
+
+// Purge counts by mservice account.
+type purgeCounts map[int64]*PurgeCount
+
+// Helper to get the count for an account, adding it if needed.
+func (c purgeCounts) get(mserviceId int64) *PurgeCount {
+	count, ok := c[mserviceId]
+	if !ok {
+		count = &PurgeCount{MserviceId: mserviceId}
+		c[mserviceId] = count
+	}
+
+	return count
+}
+
+// Helper to list the counts, ordered by account.
+func (c purgeCounts) list() []*PurgeCount {
+	var counts []*PurgeCount
+	for _, count := range c {
+		counts = append(counts, count)
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].MserviceId < counts[j].MserviceId
+	})
+
+	return counts
+}
+
+// AuditLog is the sink for the audit trail of calls to the service, kept apart from the address book
+// records and written outside their transactions.
+type AuditLog interface {
+	// write an entry to the audit log, setting its identifier and date
+	WriteAuditEntry(ctx context.Context, entry *pb.AuditEntry) error
+	// get the audit entries of an mservice account matching the non-empty fields of the filter, most
+	// recent first, returning at most filter.limit entries (0 for all)
+	QueryAuditLog(ctx context.Context, filter *pb.QueryAuditLogRequest) ([]*pb.AuditEntry, error)
+}
+
+// Result of a create call made with an idempotency key, kept to replay for repeats of the call.
+type IdempotencyEntry struct {
+	// mservice account identifier
+	MserviceId int64
+	// idempotency key sent by the client
+	Key string
+	// name of the rpc method
+	Rpc string
+	// hash of the request, to tell a repeat from another request with the same key
+	RequestHash string
+	// response of the call, as protobuf JSON
+	Response string
+	// date the key was first used
+	Created time.Time
+	// date after which the key may be used again
+	Expires time.Time
+}
+
+// Store is the persistent storage for parties and their child addresses and phones.
+//
+// Update and delete methods take the current version of the record and return the new
+// version; ErrNotFound is returned if no live record matches that version. Creating an address
+// or phone returns ErrConflict if the party has a live one of that type.
+//
+// Writes do not record history themselves; RecordHistory is called in the same transaction
+// after changing a party or its children.
+type Store interface {
+	// create new party, returning the party identifier
+	CreateParty(ctx context.Context, party *pb.Party) (int64, error)
+	// update an existing party
+	UpdateParty(ctx context.Context, party *pb.Party) (int32, error)
+	// delete an existing party
+	DeleteParty(ctx context.Context, mserviceId int64, partyId int64, version int32) (int32, error)
+	// get party by id
+	GetParty(ctx context.Context, mserviceId int64, partyId int64) (*pb.Party, error)
+	// get parties by mservice id, in orderBy order starting after the cursor (nil for the first page),
+	// returning at most limit parties (0 for all)
+	GetParties(ctx context.Context, mserviceId int64, orderBy string, after *PartyCursor, limit int) ([]*pb.Party, error)
+	// search parties by the non-empty fields of the request
+	SearchParties(ctx context.Context, filter *pb.SearchPartiesRequest) ([]*pb.Party, error)
+	// get deleted parties by mservice id, most recently deleted first
+	GetDeletedParties(ctx context.Context, mserviceId int64) ([]*pb.Party, error)
+	// restore a deleted party
+	RestoreParty(ctx context.Context, mserviceId int64, partyId int64, version int32) (int32, error)
+
+	// create a new address for a party, reviving a deleted address of the same type with a fresh version
+	CreateAddress(ctx context.Context, addr *pb.Address) (int32, error)
+	// create new addresses for parties in one batch, in empty slots; all start at version 1
+	CreateAddresses(ctx context.Context, addrs []*pb.Address) error
+	// update an existing address for a party
+	UpdateAddress(ctx context.Context, addr *pb.Address) (int32, error)
+	// delete an existing address for a party
+	DeleteAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32, version int32) (int32, error)
+	// delete all live addresses for a party, bumping their versions
+	DeleteAddresses(ctx context.Context, mserviceId int64, partyId int64) error
+	// restore a deleted address for a party
+	RestoreAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32, version int32) (int32, error)
+	// restore the addresses deleted with a party, at or after its deletion, bumping their versions
+	RestoreAddresses(ctx context.Context, mserviceId int64, partyId int64) error
+	// get an address for a party by type
+	GetAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32) (*pb.Address, error)
+	// get all addresses for a party
+	GetAddresses(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.Address, error)
+	// get all addresses for the parties in an identifier range, ordered by party and type
+	GetAddressesForParties(ctx context.Context, mserviceId int64, firstPartyId int64, lastPartyId int64) ([]*pb.Address, error)
+
+	// create a new phone for a party, reviving a deleted phone of the same type with a fresh version
+	CreatePhone(ctx context.Context, phone *pb.Phone) (int32, error)
+	// create new phones for parties in one batch, in empty slots; all start at version 1
+	CreatePhones(ctx context.Context, phones []*pb.Phone) error
+	// update an existing phone for a party
+	UpdatePhone(ctx context.Context, phone *pb.Phone) (int32, error)
+	// delete an existing phone for a party
+	DeletePhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32, version int32) (int32, error)
+	// delete all live phones for a party, bumping their versions
+	DeletePhones(ctx context.Context, mserviceId int64, partyId int64) error
+	// restore a deleted phone for a party
+	RestorePhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32, version int32) (int32, error)
+	// restore the phones deleted with a party, at or after its deletion, bumping their versions
+	RestorePhones(ctx context.Context, mserviceId int64, partyId int64) error
+	// get a phone for a party by type
+	GetPhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32) (*pb.Phone, error)
+	// get all phones for a party
+	GetPhones(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.Phone, error)
+	// get all phones for the parties in an identifier range, ordered by party and type
+	GetPhonesForParties(ctx context.Context, mserviceId int64, firstPartyId int64, lastPartyId int64) ([]*pb.Phone, error)
+
+	// record the versions of the party and its addresses and phones, deleted or not, that are not yet in
+	// the history, as changed now by changedBy in the next change sequence of the party
+	RecordHistory(ctx context.Context, mserviceId int64, partyId int64, changedBy string) error
+	// get the recorded versions of the party and its addresses and phones, oldest first; the new_party,
+	// new_address or new_phone of each entry is set, but not the old values or action
+	GetPartyHistory(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.HistoryEntry, error)
+
+	// hard delete up to limit deleted rows from each of the party, address and phone tables whose deletion
+	// date is before deletedBefore, for one mservice account or all if mserviceId is 0, returning the rows
+	// removed by account; a purged party takes its whole history with it, but the history of a purged
+	// address or phone is kept, and a new one in its slot continues from the last recorded version
+	PurgeDeleted(ctx context.Context, mserviceId int64, deletedBefore time.Time, limit int) ([]*PurgeCount, error)
+
+	// get the unexpired entry for an idempotency key of an mservice account, or ErrNotFound
+	GetIdempotencyEntry(ctx context.Context, mserviceId int64, key string) (*IdempotencyEntry, error)
+	// save the entry for an idempotency key, setting its creation date and replacing an expired entry
+	// for the key; ErrConflict is returned if the key has an unexpired entry
+	SaveIdempotencyEntry(ctx context.Context, entry *IdempotencyEntry) error
+	// hard delete the idempotency entries that expired before expiredBefore, returning the number removed
+	PurgeIdempotencyEntries(ctx context.Context, expiredBefore time.Time) (int64, error)
+
+	AuditLog
+
+	// run fn in a transaction, committing if fn returns nil and rolling back otherwise; fn must
+	// use only the tx store it is given
+	InTransaction(ctx context.Context, fn func(tx Store) error) error
+
+	// release any resources held by the store
+	Close() error
+}
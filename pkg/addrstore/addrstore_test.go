@@ -0,0 +1,481 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Get a migrated SQLite store in a temporary directory, closed when the test ends.
+func newTestSqliteStore(t *testing.T) *SqlStore {
+	t.Helper()
+
+	sqlDB, err := OpenSqliteDatabase(filepath.Join(t.TempDir(), "addrbook.db"))
+	if err != nil {
+		t.Fatalf("OpenSqliteDatabase: %v", err)
+	}
+
+	store := NewSqliteStore(sqlDB)
+	t.Cleanup(func() { store.Close() })
+
+	_, err = store.MigrateUp(context.Background())
+	if err != nil {
+		t.Fatalf("MigrateUp: %v", err)
+	}
+
+	return store
+}
+
+// Run a test against a MemoryStore, a SQLite SqlStore and, if there is a scratch database, a
+// PostgreSQL SqlStore, which must all behave the same.
+func forEachStore(t *testing.T, test func(t *testing.T, store Store)) {
+	t.Run("memory", func(t *testing.T) {
+		test(t, NewMemoryStore())
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		test(t, newTestSqliteStore(t))
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		test(t, newTestPostgresStore(t))
+	})
+}
+
+// Create a person in account 23, failing the test on any error.
+func createTestParty(t *testing.T, store Store, lastName string) int64 {
+	t.Helper()
+
+	partyId, err := store.CreateParty(context.Background(), &pb.Party{MserviceId: 23, PartyType: 1,
+		FirstName: "Frodo", LastName: lastName, Email: "frodo@baggins.org"})
+	if err != nil {
+		t.Fatalf("CreateParty: %v", err)
+	}
+
+	return partyId
+}
+
+func TestPartyVersions(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store Store) {
+		ctx := context.Background()
+		partyId := createTestParty(t, store, "Baggins")
+
+		party, err := store.GetParty(ctx, 23, partyId)
+		if (err != nil) || (party.GetVersion() != 1) {
+			t.Fatalf("GetParty: %v %v", party, err)
+		}
+
+		if _, err = store.GetParty(ctx, 24, partyId); err != ErrNotFound {
+			t.Fatalf("GetParty from another account: %v", err)
+		}
+
+		party.LastName = "Underhill"
+		version, err := store.UpdateParty(ctx, party)
+		if (err != nil) || (version != 2) {
+			t.Fatalf("UpdateParty: %d %v", version, err)
+		}
+
+		if _, err = store.UpdateParty(ctx, party); err != ErrNotFound {
+			t.Fatalf("UpdateParty with a stale version: %v", err)
+		}
+
+		version, err = store.DeleteParty(ctx, 23, partyId, 2)
+		if (err != nil) || (version != 3) {
+			t.Fatalf("DeleteParty: %d %v", version, err)
+		}
+
+		if _, err = store.GetParty(ctx, 23, partyId); err != ErrNotFound {
+			t.Fatalf("GetParty after delete: %v", err)
+		}
+	})
+}
+
+func TestChildSlots(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store Store) {
+		ctx := context.Background()
+		partyId := createTestParty(t, store, "Baggins")
+
+		phone := &pb.Phone{MserviceId: 23, PartyId: partyId, PhoneType: 3, PhoneNumber: "543-555-1111"}
+
+		version, err := store.CreatePhone(ctx, phone)
+		if (err != nil) || (version != 1) {
+			t.Fatalf("CreatePhone: %d %v", version, err)
+		}
+
+		if _, err = store.CreatePhone(ctx, phone); err != ErrConflict {
+			t.Fatalf("CreatePhone on a live slot: %v", err)
+		}
+
+		if _, err = store.DeletePhone(ctx, 23, partyId, 3, 1); err != nil {
+			t.Fatalf("DeletePhone: %v", err)
+		}
+
+		// a deleted slot is revived with the next version
+		version, err = store.CreatePhone(ctx, phone)
+		if (err != nil) || (version != 3) {
+			t.Fatalf("CreatePhone on a deleted slot: %d %v", version, err)
+		}
+	})
+}
+
+func TestInTransactionRollback(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store Store) {
+		ctx := context.Background()
+
+		var partyId int64
+
+		err := store.InTransaction(ctx, func(tx Store) error {
+			partyId = createTestParty(t, tx, "Baggins")
+			return ErrConflict
+		})
+		if err != ErrConflict {
+			t.Fatalf("InTransaction: %v", err)
+		}
+
+		if _, err = store.GetParty(ctx, 23, partyId); err != ErrNotFound {
+			t.Fatalf("GetParty after rollback: %v", err)
+		}
+	})
+}
+
+func TestSqliteConcurrentTransactions(t *testing.T) {
+	store := newTestSqliteStore(t)
+	ctx := context.Background()
+
+	// each transaction reads before it writes, as CreateAddress reads the slot of the address
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		go func() {
+			errs <- store.InTransaction(ctx, func(tx Store) error {
+				_, err := tx.GetParties(ctx, 23, "", nil, 0)
+				if err != nil {
+					return err
+				}
+
+				partyId, err := tx.CreateParty(ctx, &pb.Party{MserviceId: 23, PartyType: 1, FirstName: "Frodo",
+					LastName: "Baggins", Email: "frodo@baggins.org"})
+				if err != nil {
+					return err
+				}
+
+				_, err = tx.CreateAddress(ctx, &pb.Address{MserviceId: 23, PartyId: partyId, AddressType: 1,
+					Address_1: "1 Bag End", City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"})
+				if err != nil {
+					return err
+				}
+
+				return tx.RecordHistory(ctx, 23, partyId, "frodo")
+			})
+		}()
+	}
+
+	for i := 0; i < 8; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("InTransaction: %v", err)
+		}
+	}
+
+	parties, err := store.GetParties(ctx, 23, "", nil, 0)
+	if (err != nil) || (len(parties) != 8) {
+		t.Fatalf("GetParties: %d %v", len(parties), err)
+	}
+}
+
+func TestGetPartiesPages(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store Store) {
+		ctx := context.Background()
+
+		// duplicate last names are ordered by party identifier
+		lastNames := []string{"Took", "Baggins", "Gamgee", "Baggins", "Brandybuck"}
+		for _, lastName := range lastNames {
+			createTestParty(t, store, lastName)
+		}
+
+		var got []string
+		var after *PartyCursor
+
+		for {
+			parties, err := store.GetParties(ctx, 23, OrderByLastName, after, 2)
+			if err != nil {
+				t.Fatalf("GetParties: %v", err)
+			}
+
+			for _, party := range parties {
+				got = append(got, party.GetLastName())
+			}
+
+			if len(parties) < 2 {
+				break
+			}
+
+			after = NewPartyCursor(OrderByLastName, parties[len(parties)-1])
+		}
+
+		want := "Baggins,Baggins,Brandybuck,Gamgee,Took"
+		if strings.Join(got, ",") != want {
+			t.Fatalf("GetParties pages: %v, want %s", got, want)
+		}
+	})
+}
+
+func TestIgnoreDuplicates(t *testing.T) {
+	tests := []struct {
+		dialect *dialect
+		want    string
+	}{
+		{mysqlDialect, "INSERT IGNORE INTO tb_Phone (inbPartyId) VALUES (?)"},
+		{postgresDialect, "INSERT INTO tb_Phone (inbPartyId) VALUES (?) ON CONFLICT DO NOTHING"},
+		{sqliteDialect, "INSERT INTO tb_Phone (inbPartyId) VALUES (?) ON CONFLICT DO NOTHING"},
+	}
+
+	for _, test := range tests {
+		if got := test.dialect.ignoreDuplicates("INSERT INTO tb_Phone (inbPartyId) VALUES (?)"); got != test.want {
+			t.Errorf("%s: %s", test.dialect.name, got)
+		}
+	}
+}
+
+func TestInsertUniqueConflict(t *testing.T) {
+	store := newTestSqliteStore(t)
+	ctx := context.Background()
+	partyId := createTestParty(t, store, "Baggins")
+
+	sqlstring := `INSERT INTO tb_Phone (inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+    intVersion, inbMserviceId, chvPhoneNumber) VALUES (?, ?, NOW(), NOW(), NOW(), FALSE, 1, ?, ?)`
+
+	// as when a concurrent create fills the slot after CreatePhone found it empty
+	err := store.InTransaction(ctx, func(tx Store) error {
+		sqlTx := tx.(*SqlStore)
+
+		if err := sqlTx.insertUnique(ctx, sqlstring, partyId, 3, 23, "543-555-1111"); err != nil {
+			t.Fatalf("insertUnique: %v", err)
+		}
+
+		if err := sqlTx.insertUnique(ctx, sqlstring, partyId, 3, 23, "543-555-2222"); err != ErrConflict {
+			t.Fatalf("insertUnique of a duplicate: %v", err)
+		}
+
+		// the transaction is still usable
+		phone, err := tx.GetPhone(ctx, 23, partyId, 3)
+		if (err != nil) || (phone.GetPhoneNumber() != "543-555-1111") {
+			t.Fatalf("GetPhone after a duplicate: %v %v", phone, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("InTransaction: %v", err)
+	}
+}
+
+func TestSaveIdempotencyEntry(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store Store) {
+		ctx := context.Background()
+
+		expired := &IdempotencyEntry{MserviceId: 23, Key: "k1", Rpc: "create_party", RequestHash: "old",
+			Response: "{}", Expires: time.Now().Add(-time.Minute)}
+		if err := store.SaveIdempotencyEntry(ctx, expired); err != nil {
+			t.Fatalf("SaveIdempotencyEntry: %v", err)
+		}
+
+		// an expired entry is replaced
+		entry := &IdempotencyEntry{MserviceId: 23, Key: "k1", Rpc: "create_party", RequestHash: "new",
+			Response: "{\"partyId\":\"1\"}", Expires: time.Now().Add(time.Hour)}
+		if err := store.SaveIdempotencyEntry(ctx, entry); err != nil {
+			t.Fatalf("SaveIdempotencyEntry over an expired entry: %v", err)
+		}
+
+		// as when a concurrent call saves the key after the create found none
+		err := store.InTransaction(ctx, func(tx Store) error {
+			duplicate := &IdempotencyEntry{MserviceId: 23, Key: "k1", Rpc: "create_party", RequestHash: "other",
+				Response: "{}", Expires: time.Now().Add(time.Hour)}
+			if err := tx.SaveIdempotencyEntry(ctx, duplicate); err != ErrConflict {
+				t.Fatalf("SaveIdempotencyEntry of a duplicate: %v", err)
+			}
+
+			// the transaction is still usable
+			saved, err := tx.GetIdempotencyEntry(ctx, 23, "k1")
+			if (err != nil) || (saved.RequestHash != "new") {
+				t.Fatalf("GetIdempotencyEntry after a duplicate: %v %v", saved, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("InTransaction: %v", err)
+		}
+
+		other := &IdempotencyEntry{MserviceId: 24, Key: "k1", Rpc: "create_party", RequestHash: "new",
+			Response: "{}", Expires: time.Now().Add(time.Hour)}
+		if err := store.SaveIdempotencyEntry(ctx, other); err != nil {
+			t.Fatalf("SaveIdempotencyEntry for another account: %v", err)
+		}
+	})
+}
+
+// Get the versions in the history of a party for a record type, in order.
+func historyVersions(t *testing.T, store Store, partyId int64, recordType string) []int32 {
+	t.Helper()
+
+	entries, err := store.GetPartyHistory(context.Background(), 23, partyId)
+	if err != nil {
+		t.Fatalf("GetPartyHistory: %v", err)
+	}
+
+	var versions []int32
+	for _, entry := range entries {
+		if entry.GetRecordType() == recordType {
+			versions = append(versions, entry.GetVersion())
+		}
+	}
+
+	return versions
+}
+
+func TestPurgeKeepsChildHistory(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store Store) {
+		ctx := context.Background()
+		partyId := createTestParty(t, store, "Baggins")
+		phone := &pb.Phone{MserviceId: 23, PartyId: partyId, PhoneType: 3, PhoneNumber: "543-555-1111"}
+
+		record := func() {
+			if err := store.RecordHistory(ctx, 23, partyId, "frodo"); err != nil {
+				t.Fatalf("RecordHistory: %v", err)
+			}
+		}
+
+		if _, err := store.CreatePhone(ctx, phone); err != nil {
+			t.Fatalf("CreatePhone: %v", err)
+		}
+		record()
+
+		if _, err := store.DeletePhone(ctx, 23, partyId, 3, 1); err != nil {
+			t.Fatalf("DeletePhone: %v", err)
+		}
+		record()
+
+		counts, err := store.PurgeDeleted(ctx, 23, time.Now().Add(time.Hour), 100)
+		if (err != nil) || (len(counts) != 1) || (counts[0].Phones != 1) || (counts[0].Parties != 0) {
+			t.Fatalf("PurgeDeleted of the phone: %v %v", counts, err)
+		}
+
+		if got := historyVersions(t, store, partyId, HistoryPhone); len(got) != 2 {
+			t.Fatalf("phone history after purge: %v", got)
+		}
+
+		// a new phone in the purged slot follows the versions in its history
+		version, err := store.CreatePhone(ctx, phone)
+		if (err != nil) || (version != 3) {
+			t.Fatalf("CreatePhone in a purged slot: %d %v", version, err)
+		}
+		record()
+
+		if got := historyVersions(t, store, partyId, HistoryPhone); len(got) != 3 {
+			t.Fatalf("phone history after create: %v", got)
+		}
+
+		// as the service deletes a party with its children
+		if _, err = store.DeletePhone(ctx, 23, partyId, 3, 3); err != nil {
+			t.Fatalf("DeletePhone: %v", err)
+		}
+
+		if _, err = store.DeleteParty(ctx, 23, partyId, 1); err != nil {
+			t.Fatalf("DeleteParty: %v", err)
+		}
+
+		counts, err = store.PurgeDeleted(ctx, 23, time.Now().Add(time.Hour), 100)
+		if (err != nil) || (len(counts) != 1) || (counts[0].Parties != 1) || (counts[0].Phones != 1) {
+			t.Fatalf("PurgeDeleted of the party: %v %v", counts, err)
+		}
+
+		if got := historyVersions(t, store, partyId, HistoryPhone); len(got) != 0 {
+			t.Fatalf("phone history after the party was purged: %v", got)
+		}
+	})
+}
+
+func TestAuditLog(t *testing.T) {
+	forEachStore(t, func(t *testing.T, store Store) {
+		ctx := context.Background()
+
+		written := []*pb.AuditEntry{
+			{UserId: "frodo", MserviceId: 23, Claim: "addradmin", Rpc: "create_party", PartyId: 7},
+			{UserId: "sam", MserviceId: 23, Claim: "addrrw", Rpc: "get_party", PartyId: 7, ErrorCode: 404},
+			{UserId: "frodo", MserviceId: 23, Claim: "addradmin", Rpc: "get_party", PartyId: 8},
+			{UserId: "frodo", MserviceId: 24, Claim: "addradmin", Rpc: "get_party", PartyId: 7},
+		}
+
+		for _, entry := range written {
+			if err := store.WriteAuditEntry(ctx, entry); err != nil {
+				t.Fatalf("WriteAuditEntry: %v", err)
+			}
+
+			if (entry.GetAuditId() == 0) || (entry.GetLogged() == nil) {
+				t.Fatalf("WriteAuditEntry did not set the id and date: %v", entry)
+			}
+		}
+
+		hourAgo := dml.DateTimeFromTime(time.Now().Add(-time.Hour))
+		hourAhead := dml.DateTimeFromTime(time.Now().Add(time.Hour))
+
+		tests := []struct {
+			name   string
+			filter *pb.QueryAuditLogRequest
+			want   []int
+		}{
+			{"account", &pb.QueryAuditLogRequest{MserviceId: 23}, []int{2, 1, 0}},
+			{"user", &pb.QueryAuditLogRequest{MserviceId: 23, UserId: "frodo"}, []int{2, 0}},
+			{"party", &pb.QueryAuditLogRequest{MserviceId: 23, PartyId: 7}, []int{1, 0}},
+			{"limit", &pb.QueryAuditLogRequest{MserviceId: 23, Limit: 2}, []int{2, 1}},
+			{"logged range", &pb.QueryAuditLogRequest{MserviceId: 23, LoggedFrom: hourAgo, LoggedTo: hourAhead},
+				[]int{2, 1, 0}},
+			{"logged from later", &pb.QueryAuditLogRequest{MserviceId: 23, LoggedFrom: hourAhead}, nil},
+			{"logged to earlier", &pb.QueryAuditLogRequest{MserviceId: 23, LoggedTo: hourAgo}, nil},
+			{"other account", &pb.QueryAuditLogRequest{MserviceId: 25}, nil},
+		}
+
+		for _, test := range tests {
+			entries, err := store.QueryAuditLog(ctx, test.filter)
+			if err != nil {
+				t.Fatalf("QueryAuditLog %s: %v", test.name, err)
+			}
+
+			var got []int
+			for _, entry := range entries {
+				for i, w := range written {
+					if entry.GetAuditId() == w.GetAuditId() {
+						got = append(got, i)
+						if (entry.GetUserId() != w.GetUserId()) || (entry.GetRpc() != w.GetRpc()) ||
+							(entry.GetClaim() != w.GetClaim()) || (entry.GetErrorCode() != w.GetErrorCode()) {
+							t.Fatalf("QueryAuditLog %s: %v, written %v", test.name, entry, w)
+						}
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("QueryAuditLog %s: entries %v, want %v", test.name, got, test.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,1332 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Key for an address or phone record, the equivalent of the (inbPartyId, intXxxType) primary key.
+type childKey struct {
+	partyId   int64
+	childType int32
+}
+
+// MemoryStore is an in-memory implementation of Store, for tests and demonstration servers.
+// It is safe for concurrent use, and follows the same soft delete and version rules as SqlStore.
+type MemoryStore struct {
+	mu          sync.RWMutex
+	lastPartyId int64
+	parties     map[int64]*pb.Party
+	addresses   map[childKey]*pb.Address
+	phones      map[childKey]*pb.Phone
+	// recorded versions by party, in the order recorded
+	history map[int64][]*pb.HistoryEntry
+	// idempotency entries by account and key
+	idempotency map[idempotencyKey]*IdempotencyEntry
+	// audit trail, shared with transactions and never rolled back
+	audit *memoryAuditLog
+}
+
+// Key for an idempotency entry, the equivalent of the (inbMserviceId, chvKey) primary key.
+type idempotencyKey struct {
+	mserviceId int64
+	key        string
+}
+
+// Audit entries of a MemoryStore, oldest first.
+type memoryAuditLog struct {
+	mu          sync.Mutex
+	lastAuditId int64
+	entries     []*pb.AuditEntry
+}
+
+// Get a new, empty MemoryStore instance.
+func NewMemoryStore() *MemoryStore {
+	store := MemoryStore{}
+	store.parties = make(map[int64]*pb.Party)
+	store.addresses = make(map[childKey]*pb.Address)
+	store.phones = make(map[childKey]*pb.Phone)
+	store.history = make(map[int64][]*pb.HistoryEntry)
+	store.idempotency = make(map[idempotencyKey]*IdempotencyEntry)
+	store.audit = &memoryAuditLog{}
+	return &store
+}
+
+// Close does nothing for a MemoryStore.
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+// Seed the store from a JSON fixture file holding an array of party wrappers, each with its
+// mservice_id and optional addresses and phones. A party_id of zero is assigned by the store.
+func (s *MemoryStore) LoadFixture(fixturePath string) error {
+	data, err := ioutil.ReadFile(fixturePath)
+	if err != nil {
+		return err
+	}
+
+	var wrappers []*pb.PartyWrapper
+	err = json.Unmarshal(data, &wrappers)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	for _, wrap := range wrappers {
+		party := pb.Party{}
+		party.PartyId = wrap.GetPartyId()
+		party.Version = wrap.GetVersion()
+		party.MserviceId = wrap.GetMserviceId()
+		party.PartyType = wrap.GetPartyType()
+		party.LastName = wrap.GetLastName()
+		party.MiddleName = wrap.GetMiddleName()
+		party.FirstName = wrap.GetFirstName()
+		party.Nickname = wrap.GetNickname()
+		party.Company = wrap.GetCompany()
+		party.Email = wrap.GetEmail()
+
+		if party.PartyId == 0 {
+			party.PartyId = s.lastPartyId + 1
+		}
+
+		if _, ok := s.parties[party.PartyId]; ok {
+			return fmt.Errorf("duplicate party_id %d in fixture", party.PartyId)
+		}
+
+		if party.PartyId > s.lastPartyId {
+			s.lastPartyId = party.PartyId
+		}
+
+		if party.Version == 0 {
+			party.Version = 1
+		}
+
+		party.Created = now
+		party.Modified = now
+		party.Deleted = now
+		s.parties[party.PartyId] = &party
+
+		for _, addr := range wrap.GetAddresses() {
+			addr = proto.Clone(addr).(*pb.Address)
+			addr.PartyId = party.PartyId
+			addr.MserviceId = party.MserviceId
+			addr.AddressTypeName = ""
+			if addr.Version == 0 {
+				addr.Version = 1
+			}
+			addr.Created = now
+			addr.Modified = now
+			addr.Deleted = now
+			s.addresses[childKey{addr.PartyId, addr.AddressType}] = addr
+		}
+
+		for _, phone := range wrap.GetPhones() {
+			phone = proto.Clone(phone).(*pb.Phone)
+			phone.PartyId = party.PartyId
+			phone.MserviceId = party.MserviceId
+			phone.PhoneTypeName = ""
+			if phone.Version == 0 {
+				phone.Version = 1
+			}
+			phone.Created = now
+			phone.Modified = now
+			phone.Deleted = now
+			s.phones[childKey{phone.PartyId, phone.PhoneType}] = phone
+		}
+
+		// the fixture records start their history, as change 0
+		s.recordHistory(party.MserviceId, party.PartyId, "", 0)
+	}
+
+	return nil
+}
+
+// run fn against a copy of the store, keeping the copy if fn returns nil; other callers wait
+// until fn returns
+func (s *MemoryStore) InTransaction(ctx context.Context, fn func(tx Store) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := NewMemoryStore()
+	tx.lastPartyId = s.lastPartyId
+	tx.audit = s.audit
+
+	for key, rec := range s.parties {
+		tx.parties[key] = rec
+	}
+
+	for key, rec := range s.addresses {
+		tx.addresses[key] = rec
+	}
+
+	for key, rec := range s.phones {
+		tx.phones[key] = rec
+	}
+
+	for key, entries := range s.history {
+		tx.history[key] = entries
+	}
+
+	for key, entry := range s.idempotency {
+		tx.idempotency[key] = entry
+	}
+
+	err := fn(tx)
+	if err != nil {
+		return err
+	}
+
+	s.lastPartyId = tx.lastPartyId
+	s.parties = tx.parties
+	s.addresses = tx.addresses
+	s.phones = tx.phones
+	s.history = tx.history
+	s.idempotency = tx.idempotency
+
+	return nil
+}
+
+// create new party
+func (s *MemoryStore) CreateParty(ctx context.Context, party *pb.Party) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	s.lastPartyId++
+
+	rec := proto.Clone(party).(*pb.Party)
+	rec.PartyId = s.lastPartyId
+	rec.Created = now
+	rec.Modified = now
+	rec.Deleted = now
+	rec.IsDeleted = false
+	rec.Version = 1
+	rec.PartyTypeName = ""
+
+	s.parties[rec.PartyId] = rec
+
+	return rec.PartyId, nil
+}
+
+// Helper to find a live party, with the lock held.
+func (s *MemoryStore) findParty(mserviceId int64, partyId int64) *pb.Party {
+	rec, ok := s.parties[partyId]
+	if !ok || rec.GetMserviceId() != mserviceId || rec.GetIsDeleted() {
+		return nil
+	}
+
+	return rec
+}
+
+// Helper to find a deleted party, with the lock held.
+func (s *MemoryStore) findDeletedParty(mserviceId int64, partyId int64) *pb.Party {
+	rec, ok := s.parties[partyId]
+	if !ok || rec.GetMserviceId() != mserviceId || !rec.GetIsDeleted() {
+		return nil
+	}
+
+	return rec
+}
+
+// update an existing party
+func (s *MemoryStore) UpdateParty(ctx context.Context, party *pb.Party) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findParty(party.GetMserviceId(), party.GetPartyId())
+	if rec == nil || rec.GetVersion() != party.GetVersion() {
+		return 0, ErrNotFound
+	}
+
+	rec = replaceParty(rec)
+	s.parties[rec.PartyId] = rec
+
+	rec.Modified = dml.DateTimeFromTime(time.Now())
+	rec.Version++
+	rec.PartyType = party.GetPartyType()
+	rec.LastName = party.GetLastName()
+	rec.MiddleName = party.GetMiddleName()
+	rec.FirstName = party.GetFirstName()
+	rec.Nickname = party.GetNickname()
+	rec.Company = party.GetCompany()
+	rec.Email = party.GetEmail()
+
+	return rec.Version, nil
+}
+
+// delete an existing party
+func (s *MemoryStore) DeleteParty(ctx context.Context, mserviceId int64, partyId int64, version int32) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findParty(mserviceId, partyId)
+	if rec == nil || rec.GetVersion() != version {
+		return 0, ErrNotFound
+	}
+
+	rec = replaceParty(rec)
+	s.parties[rec.PartyId] = rec
+
+	rec.Deleted = dml.DateTimeFromTime(time.Now())
+	rec.IsDeleted = true
+	rec.Version++
+
+	return rec.Version, nil
+}
+
+// restore a deleted party
+func (s *MemoryStore) RestoreParty(ctx context.Context, mserviceId int64, partyId int64, version int32) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findDeletedParty(mserviceId, partyId)
+	if rec == nil || rec.GetVersion() != version {
+		return 0, ErrNotFound
+	}
+
+	rec = replaceParty(rec)
+	s.parties[rec.PartyId] = rec
+
+	rec.Modified = dml.DateTimeFromTime(time.Now())
+	rec.IsDeleted = false
+	rec.Version++
+
+	return rec.Version, nil
+}
+
+// get deleted parties by mservice id, most recently deleted first
+func (s *MemoryStore) GetDeletedParties(ctx context.Context, mserviceId int64) ([]*pb.Party, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var parties []*pb.Party
+
+	for _, rec := range s.parties {
+		if rec.GetMserviceId() == mserviceId && rec.GetIsDeleted() {
+			parties = append(parties, proto.Clone(rec).(*pb.Party))
+		}
+	}
+
+	sort.Slice(parties, func(i, j int) bool {
+		a := parties[i].GetDeleted().GetMilliseconds()
+		b := parties[j].GetDeleted().GetMilliseconds()
+		if a != b {
+			return a > b
+		}
+		return parties[i].GetPartyId() < parties[j].GetPartyId()
+	})
+
+	return parties, nil
+}
+
+// get party by id
+func (s *MemoryStore) GetParty(ctx context.Context, mserviceId int64, partyId int64) (*pb.Party, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rec := s.findParty(mserviceId, partyId)
+	if rec == nil {
+		return nil, ErrNotFound
+	}
+
+	return copyParty(rec), nil
+}
+
+// get parties by mservice id, in orderBy order starting after the cursor (nil for the first page),
+// returning at most limit parties (0 for all)
+func (s *MemoryStore) GetParties(ctx context.Context, mserviceId int64, orderBy string, after *PartyCursor, limit int) ([]*pb.Party, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var parties []*pb.Party
+
+	for _, rec := range s.parties {
+		if rec.GetMserviceId() == mserviceId && !rec.GetIsDeleted() {
+			if (after == nil) || comparePartyCursor(NewPartyCursor(orderBy, rec), after) > 0 {
+				parties = append(parties, copyParty(rec))
+			}
+		}
+	}
+
+	sort.Slice(parties, func(i, j int) bool {
+		return comparePartyCursor(NewPartyCursor(orderBy, parties[i]), NewPartyCursor(orderBy, parties[j])) < 0
+	})
+
+	if (limit > 0) && (len(parties) > limit) {
+		parties = parties[:limit]
+	}
+
+	return parties, nil
+}
+
+// Helper to compare party cursors for the same sort order.
+func comparePartyCursor(a *PartyCursor, b *PartyCursor) int {
+	if a.Value != b.Value {
+		return strings.Compare(a.Value, b.Value)
+	}
+
+	if a.PartyId < b.PartyId {
+		return -1
+	} else if a.PartyId > b.PartyId {
+		return 1
+	}
+
+	return 0
+}
+
+// search parties by the non-empty fields of the request
+func (s *MemoryStore) SearchParties(ctx context.Context, filter *pb.SearchPartiesRequest) ([]*pb.Party, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var parties []*pb.Party
+
+	for _, rec := range s.parties {
+		if rec.GetMserviceId() == filter.GetMserviceId() && !rec.GetIsDeleted() && s.matchParty(rec, filter) {
+			parties = append(parties, copyParty(rec))
+		}
+	}
+
+	sort.Slice(parties, func(i, j int) bool {
+		return parties[i].GetPartyId() < parties[j].GetPartyId()
+	})
+
+	return parties, nil
+}
+
+// Helper to match a live party against a search filter, with the lock held.
+func (s *MemoryStore) matchParty(rec *pb.Party, filter *pb.SearchPartiesRequest) bool {
+	if !strings.HasPrefix(strings.ToLower(rec.GetLastName()), strings.ToLower(filter.GetLastName())) {
+		return false
+	}
+
+	if !strings.HasPrefix(strings.ToLower(rec.GetFirstName()), strings.ToLower(filter.GetFirstName())) {
+		return false
+	}
+
+	if (filter.GetCompany() != "") && !strings.EqualFold(rec.GetCompany(), filter.GetCompany()) {
+		return false
+	}
+
+	if (filter.GetEmail() != "") && !strings.EqualFold(rec.GetEmail(), filter.GetEmail()) {
+		return false
+	}
+
+	if (filter.GetPartyType() != 0) && (rec.GetPartyType() != filter.GetPartyType()) {
+		return false
+	}
+
+	if filter.GetPhoneNumber() != "" {
+		found := false
+		for key, phone := range s.phones {
+			if key.partyId == rec.GetPartyId() && !phone.GetIsDeleted() &&
+				phone.GetPhoneNumber() == filter.GetPhoneNumber() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if (filter.GetCity() != "") || (filter.GetState() != "") || (filter.GetPostalCode() != "") {
+		found := false
+		for key, addr := range s.addresses {
+			if key.partyId != rec.GetPartyId() || addr.GetIsDeleted() {
+				continue
+			}
+
+			if (filter.GetCity() != "") && !strings.EqualFold(addr.GetCity(), filter.GetCity()) {
+				continue
+			}
+
+			if (filter.GetState() != "") && !strings.EqualFold(addr.GetState(), filter.GetState()) {
+				continue
+			}
+
+			if (filter.GetPostalCode() != "") && (addr.GetPostalCode() != filter.GetPostalCode()) {
+				continue
+			}
+
+			found = true
+			break
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// create a new address for a party, reviving a deleted address of the same type with a fresh version
+func (s *MemoryStore) CreateAddress(ctx context.Context, addr *pb.Address) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := childKey{addr.GetPartyId(), addr.GetAddressType()}
+
+	version := s.firstChildVersion(addr.GetPartyId(), HistoryAddress, addr.GetAddressType())
+
+	if old, ok := s.addresses[key]; ok {
+		if !old.GetIsDeleted() {
+			return 0, ErrConflict
+		}
+
+		version = old.GetVersion() + 1
+	}
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	rec := proto.Clone(addr).(*pb.Address)
+	rec.Created = now
+	rec.Modified = now
+	rec.Deleted = now
+	rec.IsDeleted = false
+	rec.Version = version
+	rec.AddressTypeName = ""
+
+	s.addresses[key] = rec
+
+	return rec.Version, nil
+}
+
+// create new addresses for parties in one batch, in empty slots; all start at version 1
+func (s *MemoryStore) CreateAddresses(ctx context.Context, addrs []*pb.Address) error {
+	return s.InTransaction(ctx, func(tx Store) error {
+		for _, addr := range addrs {
+			_, err := tx.CreateAddress(ctx, addr)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Helper to find a deleted address, with the lock held.
+func (s *MemoryStore) findDeletedAddress(mserviceId int64, partyId int64, addressType int32) *pb.Address {
+	rec, ok := s.addresses[childKey{partyId, addressType}]
+	if !ok || rec.GetMserviceId() != mserviceId || !rec.GetIsDeleted() {
+		return nil
+	}
+
+	return rec
+}
+
+// Helper to find a live address, with the lock held.
+func (s *MemoryStore) findAddress(mserviceId int64, partyId int64, addressType int32) *pb.Address {
+	rec, ok := s.addresses[childKey{partyId, addressType}]
+	if !ok || rec.GetMserviceId() != mserviceId || rec.GetIsDeleted() {
+		return nil
+	}
+
+	return rec
+}
+
+// update an existing address for a party
+func (s *MemoryStore) UpdateAddress(ctx context.Context, addr *pb.Address) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findAddress(addr.GetMserviceId(), addr.GetPartyId(), addr.GetAddressType())
+	if rec == nil || rec.GetVersion() != addr.GetVersion() {
+		return 0, ErrNotFound
+	}
+
+	rec = replaceAddress(rec)
+	s.addresses[childKey{rec.PartyId, rec.AddressType}] = rec
+
+	rec.Modified = dml.DateTimeFromTime(time.Now())
+	rec.Version++
+	rec.Address_1 = addr.GetAddress_1()
+	rec.Address_2 = addr.GetAddress_2()
+	rec.City = addr.GetCity()
+	rec.State = addr.GetState()
+	rec.PostalCode = addr.GetPostalCode()
+	rec.CountryCode = addr.GetCountryCode()
+
+	return rec.Version, nil
+}
+
+// delete an existing address for a party
+func (s *MemoryStore) DeleteAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32,
+	version int32) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findAddress(mserviceId, partyId, addressType)
+	if rec == nil || rec.GetVersion() != version {
+		return 0, ErrNotFound
+	}
+
+	rec = replaceAddress(rec)
+	s.addresses[childKey{rec.PartyId, rec.AddressType}] = rec
+
+	rec.Deleted = dml.DateTimeFromTime(time.Now())
+	rec.IsDeleted = true
+	rec.Version++
+
+	return rec.Version, nil
+}
+
+// delete all live addresses for a party, bumping their versions
+func (s *MemoryStore) DeleteAddresses(ctx context.Context, mserviceId int64, partyId int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	for key, rec := range s.addresses {
+		if rec.GetPartyId() != partyId || rec.GetMserviceId() != mserviceId || rec.GetIsDeleted() {
+			continue
+		}
+
+		rec = replaceAddress(rec)
+		s.addresses[key] = rec
+
+		rec.Deleted = now
+		rec.IsDeleted = true
+		rec.Version++
+	}
+
+	return nil
+}
+
+// restore a deleted address for a party
+func (s *MemoryStore) RestoreAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32,
+	version int32) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findDeletedAddress(mserviceId, partyId, addressType)
+	if rec == nil || rec.GetVersion() != version {
+		return 0, ErrNotFound
+	}
+
+	rec = replaceAddress(rec)
+	s.addresses[childKey{rec.PartyId, rec.AddressType}] = rec
+
+	rec.Modified = dml.DateTimeFromTime(time.Now())
+	rec.IsDeleted = false
+	rec.Version++
+
+	return rec.Version, nil
+}
+
+// restore the addresses deleted with a party, at or after its deletion, bumping their versions
+func (s *MemoryStore) RestoreAddresses(ctx context.Context, mserviceId int64, partyId int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	party, ok := s.parties[partyId]
+	if !ok {
+		return nil
+	}
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	for key, rec := range s.addresses {
+		if rec.GetPartyId() != partyId || rec.GetMserviceId() != mserviceId || !rec.GetIsDeleted() ||
+			rec.GetDeleted().GetMilliseconds() < party.GetDeleted().GetMilliseconds() {
+			continue
+		}
+
+		rec = replaceAddress(rec)
+		s.addresses[key] = rec
+
+		rec.Modified = now
+		rec.IsDeleted = false
+		rec.Version++
+	}
+
+	return nil
+}
+
+// get an address for a party by type
+func (s *MemoryStore) GetAddress(ctx context.Context, mserviceId int64, partyId int64,
+	addressType int32) (*pb.Address, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rec := s.findAddress(mserviceId, partyId, addressType)
+	if rec == nil {
+		return nil, ErrNotFound
+	}
+
+	return copyAddress(rec), nil
+}
+
+// get all addresses for a party
+func (s *MemoryStore) GetAddresses(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.Address, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var addrs []*pb.Address
+
+	for key, rec := range s.addresses {
+		if key.partyId == partyId && rec.GetMserviceId() == mserviceId && !rec.GetIsDeleted() {
+			addrs = append(addrs, copyAddress(rec))
+		}
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].GetAddressType() < addrs[j].GetAddressType()
+	})
+
+	return addrs, nil
+}
+
+// get all addresses for the parties in an identifier range, ordered by party and type
+func (s *MemoryStore) GetAddressesForParties(ctx context.Context, mserviceId int64, firstPartyId int64, lastPartyId int64) ([]*pb.Address, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var addrs []*pb.Address
+
+	for key, rec := range s.addresses {
+		if key.partyId >= firstPartyId && key.partyId <= lastPartyId && rec.GetMserviceId() == mserviceId &&
+			!rec.GetIsDeleted() {
+			addrs = append(addrs, copyAddress(rec))
+		}
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		if addrs[i].GetPartyId() != addrs[j].GetPartyId() {
+			return addrs[i].GetPartyId() < addrs[j].GetPartyId()
+		}
+		return addrs[i].GetAddressType() < addrs[j].GetAddressType()
+	})
+
+	return addrs, nil
+}
+
+// create a new phone for a party, reviving a deleted phone of the same type with a fresh version
+func (s *MemoryStore) CreatePhone(ctx context.Context, phone *pb.Phone) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := childKey{phone.GetPartyId(), phone.GetPhoneType()}
+
+	version := s.firstChildVersion(phone.GetPartyId(), HistoryPhone, phone.GetPhoneType())
+
+	if old, ok := s.phones[key]; ok {
+		if !old.GetIsDeleted() {
+			return 0, ErrConflict
+		}
+
+		version = old.GetVersion() + 1
+	}
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	rec := proto.Clone(phone).(*pb.Phone)
+	rec.Created = now
+	rec.Modified = now
+	rec.Deleted = now
+	rec.IsDeleted = false
+	rec.Version = version
+	rec.PhoneTypeName = ""
+
+	s.phones[key] = rec
+
+	return rec.Version, nil
+}
+
+// create new phones for parties in one batch, in empty slots; all start at version 1
+func (s *MemoryStore) CreatePhones(ctx context.Context, phones []*pb.Phone) error {
+	return s.InTransaction(ctx, func(tx Store) error {
+		for _, phone := range phones {
+			_, err := tx.CreatePhone(ctx, phone)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Helper to find a deleted phone, with the lock held.
+func (s *MemoryStore) findDeletedPhone(mserviceId int64, partyId int64, phoneType int32) *pb.Phone {
+	rec, ok := s.phones[childKey{partyId, phoneType}]
+	if !ok || rec.GetMserviceId() != mserviceId || !rec.GetIsDeleted() {
+		return nil
+	}
+
+	return rec
+}
+
+// Helper to find a live phone, with the lock held.
+func (s *MemoryStore) findPhone(mserviceId int64, partyId int64, phoneType int32) *pb.Phone {
+	rec, ok := s.phones[childKey{partyId, phoneType}]
+	if !ok || rec.GetMserviceId() != mserviceId || rec.GetIsDeleted() {
+		return nil
+	}
+
+	return rec
+}
+
+// update an existing phone for a party
+func (s *MemoryStore) UpdatePhone(ctx context.Context, phone *pb.Phone) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findPhone(phone.GetMserviceId(), phone.GetPartyId(), phone.GetPhoneType())
+	if rec == nil || rec.GetVersion() != phone.GetVersion() {
+		return 0, ErrNotFound
+	}
+
+	rec = replacePhone(rec)
+	s.phones[childKey{rec.PartyId, rec.PhoneType}] = rec
+
+	rec.Modified = dml.DateTimeFromTime(time.Now())
+	rec.Version++
+	rec.PhoneNumber = phone.GetPhoneNumber()
+
+	return rec.Version, nil
+}
+
+// delete an existing phone for a party
+func (s *MemoryStore) DeletePhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32,
+	version int32) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findPhone(mserviceId, partyId, phoneType)
+	if rec == nil || rec.GetVersion() != version {
+		return 0, ErrNotFound
+	}
+
+	rec = replacePhone(rec)
+	s.phones[childKey{rec.PartyId, rec.PhoneType}] = rec
+
+	rec.Deleted = dml.DateTimeFromTime(time.Now())
+	rec.IsDeleted = true
+	rec.Version++
+
+	return rec.Version, nil
+}
+
+// delete all live phones for a party, bumping their versions
+func (s *MemoryStore) DeletePhones(ctx context.Context, mserviceId int64, partyId int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	for key, rec := range s.phones {
+		if rec.GetPartyId() != partyId || rec.GetMserviceId() != mserviceId || rec.GetIsDeleted() {
+			continue
+		}
+
+		rec = replacePhone(rec)
+		s.phones[key] = rec
+
+		rec.Deleted = now
+		rec.IsDeleted = true
+		rec.Version++
+	}
+
+	return nil
+}
+
+// restore a deleted phone for a party
+func (s *MemoryStore) RestorePhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32,
+	version int32) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.findDeletedPhone(mserviceId, partyId, phoneType)
+	if rec == nil || rec.GetVersion() != version {
+		return 0, ErrNotFound
+	}
+
+	rec = replacePhone(rec)
+	s.phones[childKey{rec.PartyId, rec.PhoneType}] = rec
+
+	rec.Modified = dml.DateTimeFromTime(time.Now())
+	rec.IsDeleted = false
+	rec.Version++
+
+	return rec.Version, nil
+}
+
+// restore the phones deleted with a party, at or after its deletion, bumping their versions
+func (s *MemoryStore) RestorePhones(ctx context.Context, mserviceId int64, partyId int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	party, ok := s.parties[partyId]
+	if !ok {
+		return nil
+	}
+
+	now := dml.DateTimeFromTime(time.Now())
+
+	for key, rec := range s.phones {
+		if rec.GetPartyId() != partyId || rec.GetMserviceId() != mserviceId || !rec.GetIsDeleted() ||
+			rec.GetDeleted().GetMilliseconds() < party.GetDeleted().GetMilliseconds() {
+			continue
+		}
+
+		rec = replacePhone(rec)
+		s.phones[key] = rec
+
+		rec.Modified = now
+		rec.IsDeleted = false
+		rec.Version++
+	}
+
+	return nil
+}
+
+// get a phone for a party by type
+func (s *MemoryStore) GetPhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32) (*pb.Phone, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rec := s.findPhone(mserviceId, partyId, phoneType)
+	if rec == nil {
+		return nil, ErrNotFound
+	}
+
+	return copyPhone(rec), nil
+}
+
+// get all phones for a party
+func (s *MemoryStore) GetPhones(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.Phone, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var phones []*pb.Phone
+
+	for key, rec := range s.phones {
+		if key.partyId == partyId && rec.GetMserviceId() == mserviceId && !rec.GetIsDeleted() {
+			phones = append(phones, copyPhone(rec))
+		}
+	}
+
+	sort.Slice(phones, func(i, j int) bool {
+		return phones[i].GetPhoneType() < phones[j].GetPhoneType()
+	})
+
+	return phones, nil
+}
+
+// get all phones for the parties in an identifier range, ordered by party and type
+func (s *MemoryStore) GetPhonesForParties(ctx context.Context, mserviceId int64, firstPartyId int64, lastPartyId int64) ([]*pb.Phone, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var phones []*pb.Phone
+
+	for key, rec := range s.phones {
+		if key.partyId >= firstPartyId && key.partyId <= lastPartyId && rec.GetMserviceId() == mserviceId &&
+			!rec.GetIsDeleted() {
+			phones = append(phones, copyPhone(rec))
+		}
+	}
+
+	sort.Slice(phones, func(i, j int) bool {
+		if phones[i].GetPartyId() != phones[j].GetPartyId() {
+			return phones[i].GetPartyId() < phones[j].GetPartyId()
+		}
+		return phones[i].GetPhoneType() < phones[j].GetPhoneType()
+	})
+
+	return phones, nil
+}
+
+// A deleted record that PurgeDeleted may remove.
+type purgeCandidate struct {
+	mserviceId int64
+	deleted    int64
+	remove     func()
+}
+
+// hard delete up to limit deleted rows from each of the party, address and phone tables whose deletion
+// date is before deletedBefore, with their history, for one mservice account or all if mserviceId
+// is 0, returning the rows removed by account
+func (s *MemoryStore) PurgeDeleted(ctx context.Context, mserviceId int64, deletedBefore time.Time,
+	limit int) ([]*PurgeCount, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(purgeCounts)
+	// DATETIME columns have seconds resolution
+	cutoff := deletedBefore.Truncate(time.Second).UnixNano() / int64(time.Millisecond)
+
+	purge := func(candidates []purgeCandidate, removed func(count *PurgeCount)) {
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].deleted < candidates[j].deleted
+		})
+
+		for i, candidate := range candidates {
+			if i >= limit {
+				break
+			}
+
+			candidate.remove()
+			removed(counts.get(candidate.mserviceId))
+		}
+	}
+
+	purgeable := func(msid int64, isDeleted bool, deleted int64) bool {
+		return isDeleted && (deleted < cutoff) && ((mserviceId == 0) || (msid == mserviceId))
+	}
+
+	var candidates []purgeCandidate
+	for key, rec := range s.parties {
+		if purgeable(rec.GetMserviceId(), rec.GetIsDeleted(), rec.GetDeleted().GetMilliseconds()) {
+			key := key
+			candidates = append(candidates, purgeCandidate{rec.GetMserviceId(), rec.GetDeleted().GetMilliseconds(),
+				func() {
+					// the party takes the history of its addresses and phones with it
+					delete(s.parties, key)
+					delete(s.history, key)
+				}})
+		}
+	}
+
+	purge(candidates, func(count *PurgeCount) { count.Parties++ })
+
+	candidates = nil
+	for key, rec := range s.addresses {
+		if purgeable(rec.GetMserviceId(), rec.GetIsDeleted(), rec.GetDeleted().GetMilliseconds()) {
+			key := key
+			candidates = append(candidates, purgeCandidate{rec.GetMserviceId(), rec.GetDeleted().GetMilliseconds(),
+				func() {
+					delete(s.addresses, key)
+				}})
+		}
+	}
+
+	purge(candidates, func(count *PurgeCount) { count.Addresses++ })
+
+	candidates = nil
+	for key, rec := range s.phones {
+		if purgeable(rec.GetMserviceId(), rec.GetIsDeleted(), rec.GetDeleted().GetMilliseconds()) {
+			key := key
+			candidates = append(candidates, purgeCandidate{rec.GetMserviceId(), rec.GetDeleted().GetMilliseconds(),
+				func() {
+					delete(s.phones, key)
+				}})
+		}
+	}
+
+	purge(candidates, func(count *PurgeCount) { count.Phones++ })
+
+	return counts.list(), nil
+}
+
+// record the versions of the party and its addresses and phones, deleted or not, that are not yet in
+// the history, as changed now by changedBy in the next change sequence of the party
+func (s *MemoryStore) RecordHistory(ctx context.Context, mserviceId int64, partyId int64, changedBy string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sequence int32
+	for _, entry := range s.history[partyId] {
+		if entry.GetSequence() > sequence {
+			sequence = entry.GetSequence()
+		}
+	}
+
+	s.recordHistory(mserviceId, partyId, changedBy, sequence+1)
+
+	return nil
+}
+
+// Helper to record history with the lock held. The history slice of the party is replaced rather
+// than appended to, as it may be shared with a transaction snapshot.
+func (s *MemoryStore) recordHistory(mserviceId int64, partyId int64, changedBy string, sequence int32) {
+	type versionKey struct {
+		recordType string
+		childType  int32
+		version    int32
+	}
+
+	old := s.history[partyId]
+
+	recorded := make(map[versionKey]bool)
+	for _, entry := range old {
+		recorded[versionKey{entry.GetRecordType(), entry.GetChildType(), entry.GetVersion()}] = true
+	}
+
+	changed := dml.DateTimeFromTime(time.Now())
+	var added []*pb.HistoryEntry
+
+	newEntry := func(recordType string, childType int32, version int32) *pb.HistoryEntry {
+		if recorded[versionKey{recordType, childType, version}] {
+			return nil
+		}
+
+		entry := &pb.HistoryEntry{RecordType: recordType, PartyId: partyId, ChildType: childType, Version: version,
+			Changed: changed, ChangedBy: changedBy, Sequence: sequence}
+		added = append(added, entry)
+		return entry
+	}
+
+	if rec, ok := s.parties[partyId]; ok && (rec.GetMserviceId() == mserviceId) {
+		if entry := newEntry(HistoryParty, 0, rec.GetVersion()); entry != nil {
+			entry.NewParty = proto.Clone(rec).(*pb.Party)
+			if !rec.GetIsDeleted() {
+				entry.NewParty.Deleted = nil
+			}
+		}
+	}
+
+	for _, rec := range s.addresses {
+		if (rec.GetPartyId() != partyId) || (rec.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		if entry := newEntry(HistoryAddress, rec.GetAddressType(), rec.GetVersion()); entry != nil {
+			entry.NewAddress = proto.Clone(rec).(*pb.Address)
+			if !rec.GetIsDeleted() {
+				entry.NewAddress.Deleted = nil
+			}
+		}
+	}
+
+	for _, rec := range s.phones {
+		if (rec.GetPartyId() != partyId) || (rec.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		if entry := newEntry(HistoryPhone, rec.GetPhoneType(), rec.GetVersion()); entry != nil {
+			entry.NewPhone = proto.Clone(rec).(*pb.Phone)
+			if !rec.GetIsDeleted() {
+				entry.NewPhone.Deleted = nil
+			}
+		}
+	}
+
+	if len(added) > 0 {
+		entries := make([]*pb.HistoryEntry, 0, len(old)+len(added))
+		entries = append(entries, old...)
+		s.history[partyId] = append(entries, added...)
+	}
+}
+
+// Helper to get the version of a new address or phone in an empty slot, following any versions left
+// in its history by a purged record, with the lock held.
+func (s *MemoryStore) firstChildVersion(partyId int64, recordType string, childType int32) int32 {
+	var version int32
+
+	for _, entry := range s.history[partyId] {
+		if (entry.GetRecordType() == recordType) && (entry.GetChildType() == childType) && (entry.GetVersion() > version) {
+			version = entry.GetVersion()
+		}
+	}
+
+	return version + 1
+}
+
+// get the recorded versions of the party and its addresses and phones, oldest first; the new_party,
+// new_address or new_phone of each entry is set, but not the old values or action
+func (s *MemoryStore) GetPartyHistory(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.HistoryEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries []*pb.HistoryEntry
+
+	for _, entry := range s.history[partyId] {
+		if historyMserviceId(entry) != mserviceId {
+			continue
+		}
+
+		entries = append(entries, proto.Clone(entry).(*pb.HistoryEntry))
+	}
+
+	sortHistory(entries)
+
+	return entries, nil
+}
+
+// Helper to get the mservice account of the record in a history entry.
+func historyMserviceId(entry *pb.HistoryEntry) int64 {
+	switch entry.GetRecordType() {
+	case HistoryParty:
+		return entry.GetNewParty().GetMserviceId()
+	case HistoryAddress:
+		return entry.GetNewAddress().GetMserviceId()
+	}
+
+	return entry.GetNewPhone().GetMserviceId()
+}
+
+// Helper to copy a stored party before changing it; records are replaced rather than modified,
+// so the map snapshots of a transaction can share them.
+func replaceParty(rec *pb.Party) *pb.Party {
+	return proto.Clone(rec).(*pb.Party)
+}
+
+// Helper to copy a stored address before changing it.
+func replaceAddress(rec *pb.Address) *pb.Address {
+	return proto.Clone(rec).(*pb.Address)
+}
+
+// Helper to copy a stored phone before changing it.
+func replacePhone(rec *pb.Phone) *pb.Phone {
+	return proto.Clone(rec).(*pb.Phone)
+}
+
+// Helper to copy a stored party, with only the fields SqlStore returns.
+func copyParty(rec *pb.Party) *pb.Party {
+	party := proto.Clone(rec).(*pb.Party)
+	party.Deleted = nil
+	party.IsDeleted = false
+	return party
+}
+
+// Helper to copy a stored address, with only the fields SqlStore returns.
+func copyAddress(rec *pb.Address) *pb.Address {
+	addr := proto.Clone(rec).(*pb.Address)
+	addr.Deleted = nil
+	addr.IsDeleted = false
+	return addr
+}
+
+// Helper to copy a stored phone, with only the fields SqlStore returns.
+func copyPhone(rec *pb.Phone) *pb.Phone {
+	phone := proto.Clone(rec).(*pb.Phone)
+	phone.Deleted = nil
+	phone.IsDeleted = false
+	return phone
+}
+
+// get the unexpired entry for an idempotency key of an mservice account, or ErrNotFound
+func (s *MemoryStore) GetIdempotencyEntry(ctx context.Context, mserviceId int64, key string) (*IdempotencyEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.idempotency[idempotencyKey{mserviceId, key}]
+	if !ok || entry.Expires.Before(time.Now()) {
+		return nil, ErrNotFound
+	}
+
+	found := *entry
+	return &found, nil
+}
+
+// save the entry for an idempotency key, setting its creation date and replacing an expired entry
+// for the key; ErrConflict is returned if the key has an unexpired entry
+func (s *MemoryStore) SaveIdempotencyEntry(ctx context.Context, entry *IdempotencyEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := idempotencyKey{entry.MserviceId, entry.Key}
+
+	if old, ok := s.idempotency[key]; ok && !old.Expires.Before(time.Now()) {
+		return ErrConflict
+	}
+
+	entry.Created = time.Now()
+
+	saved := *entry
+	s.idempotency[key] = &saved
+
+	return nil
+}
+
+// hard delete the idempotency entries that expired before expiredBefore, returning the number removed
+func (s *MemoryStore) PurgeIdempotencyEntries(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var removed int64
+
+	for key, entry := range s.idempotency {
+		if entry.Expires.Before(expiredBefore) {
+			delete(s.idempotency, key)
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+// write an entry to the audit log, setting its identifier and date
+func (s *MemoryStore) WriteAuditEntry(ctx context.Context, entry *pb.AuditEntry) error {
+	s.audit.mu.Lock()
+	defer s.audit.mu.Unlock()
+
+	s.audit.lastAuditId++
+	entry.AuditId = s.audit.lastAuditId
+	entry.Logged = dml.DateTimeFromTime(time.Now())
+
+	s.audit.entries = append(s.audit.entries, proto.Clone(entry).(*pb.AuditEntry))
+
+	return nil
+}
+
+// get the audit entries of an mservice account matching the non-empty fields of the filter, most
+// recent first, returning at most filter.limit entries (0 for all)
+func (s *MemoryStore) QueryAuditLog(ctx context.Context, filter *pb.QueryAuditLogRequest) ([]*pb.AuditEntry, error) {
+	s.audit.mu.Lock()
+	defer s.audit.mu.Unlock()
+
+	var entries []*pb.AuditEntry
+
+	for i := len(s.audit.entries) - 1; i >= 0; i-- {
+		entry := s.audit.entries[i]
+		if entry.GetMserviceId() != filter.GetMserviceId() {
+			continue
+		}
+		if (filter.GetUserId() != "") && (entry.GetUserId() != filter.GetUserId()) {
+			continue
+		}
+		if (filter.GetPartyId() != 0) && (entry.GetPartyId() != filter.GetPartyId()) {
+			continue
+		}
+		if (filter.GetLoggedFrom() != nil) && (entry.GetLogged().GetMilliseconds() < filter.GetLoggedFrom().GetMilliseconds()) {
+			continue
+		}
+		if (filter.GetLoggedTo() != nil) && (entry.GetLogged().GetMilliseconds() > filter.GetLoggedTo().GetMilliseconds()) {
+			continue
+		}
+
+		entries = append(entries, proto.Clone(entry).(*pb.AuditEntry))
+
+		if (filter.GetLimit() > 0) && (len(entries) == int(filter.GetLimit())) {
+			break
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,311 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Schema migrations for each dialect, named NNNN_description.up.sql and NNNN_description.down.sql.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+const versionTableSql = `CREATE TABLE IF NOT EXISTS tb_SchemaVersion
+(
+    intVersion INT NOT NULL,
+    chvName VARCHAR(100) NOT NULL,
+    dtmApplied TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (intVersion)
+)`
+
+// A single numbered schema migration.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// Status of a schema migration in the database.
+type MigrationStatus struct {
+	Version int
+	Name    string
+	Applied bool
+}
+
+// Helper to load the embedded migrations for a dialect, ordered by version.
+func loadMigrations(dialectName string) ([]*migration, error) {
+	dir := path.Join("migrations", dialectName)
+	entries, err := migrationFiles.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*migration)
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+		var direction string
+		if strings.HasSuffix(fileName, ".up.sql") {
+			direction = "up"
+		} else if strings.HasSuffix(fileName, ".down.sql") {
+			direction = "down"
+		} else {
+			continue
+		}
+
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		pos := strings.Index(base, "_")
+		if pos <= 0 {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+
+		version, err := strconv.Atoi(base[:pos])
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+
+		data, err := migrationFiles.ReadFile(path.Join(dir, fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: base[pos+1:]}
+			byVersion[version] = m
+		}
+
+		if direction == "up" {
+			m.up = string(data)
+		} else {
+			m.down = string(data)
+		}
+	}
+
+	var migrations []*migration
+	for _, m := range byVersion {
+		migrations = append(migrations, m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+
+	return migrations, nil
+}
+
+// Helper to split a migration script into statements, dropping comment lines. A statement ends at
+// a line ending with a semicolon, so a semicolon within a line, as in a string literal, is kept;
+// see migrations/README.md.
+func splitStatements(script string) []string {
+	var statements []string
+	var lines []string
+
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		lines = append(lines, line)
+
+		if strings.HasSuffix(trimmed, ";") {
+			statements = appendStatement(statements, lines)
+			lines = nil
+		}
+	}
+
+	return appendStatement(statements, lines)
+}
+
+// Helper to append the statement in a group of lines, without its terminating semicolon, if not empty.
+func appendStatement(statements []string, lines []string) []string {
+	stmt := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(strings.Join(lines, "\n")), ";"))
+	if stmt == "" {
+		return statements
+	}
+
+	return append(statements, stmt)
+}
+
+// Get the schema version this binary requires.
+func (s *SqlStore) RequiredSchemaVersion() (int, error) {
+	migrations, err := loadMigrations(s.dialect.name)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(migrations) == 0 {
+		return 0, nil
+	}
+
+	return migrations[len(migrations)-1].version, nil
+}
+
+// Get the schema version of the database, from the tb_SchemaVersion table.
+func (s *SqlStore) SchemaVersion(ctx context.Context) (int, error) {
+	var version int
+	err := s.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(intVersion), 0) FROM tb_SchemaVersion").Scan(&version)
+	return version, err
+}
+
+// Check that the database schema is at least the version this binary requires.
+func (s *SqlStore) CheckSchemaVersion(ctx context.Context) error {
+	required, err := s.RequiredSchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot read schema version, run addrserver migrate up: %v", err)
+	}
+
+	if current < required {
+		return fmt.Errorf("database schema version %d is older than required version %d, run addrserver migrate up",
+			current, required)
+	}
+
+	return nil
+}
+
+// Get the status of each migration known to this binary.
+func (s *SqlStore) MigrationStatus(ctx context.Context) ([]MigrationStatus, error) {
+	migrations, err := loadMigrations(s.dialect.name)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.ExecContext(ctx, versionTableSql)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status []MigrationStatus
+	for _, m := range migrations {
+		status = append(status, MigrationStatus{Version: m.version, Name: m.name, Applied: m.version <= current})
+	}
+
+	return status, nil
+}
+
+// Apply all pending migrations, returning the versions applied.
+func (s *SqlStore) MigrateUp(ctx context.Context) ([]int, error) {
+	migrations, err := loadMigrations(s.dialect.name)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.ExecContext(ctx, versionTableSql)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []int
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		err = s.runMigration(ctx, m.up,
+			"INSERT INTO tb_SchemaVersion (intVersion, chvName, dtmApplied) VALUES (?, ?, NOW())", m.version, m.name)
+		if err != nil {
+			return applied, fmt.Errorf("migration %d_%s: %v", m.version, m.name, err)
+		}
+
+		applied = append(applied, m.version)
+	}
+
+	return applied, nil
+}
+
+// Revert the most recently applied migration, returning its version, or 0 if none are applied.
+func (s *SqlStore) MigrateDown(ctx context.Context) (int, error) {
+	migrations, err := loadMigrations(s.dialect.name)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = s.db.ExecContext(ctx, versionTableSql)
+	if err != nil {
+		return 0, err
+	}
+
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if current == 0 {
+		return 0, nil
+	}
+
+	for _, m := range migrations {
+		if m.version == current {
+			err = s.runMigration(ctx, m.down, "DELETE FROM tb_SchemaVersion WHERE intVersion = ?", m.version)
+			if err != nil {
+				return 0, fmt.Errorf("migration %d_%s: %v", m.version, m.name, err)
+			}
+
+			return m.version, nil
+		}
+	}
+
+	return 0, fmt.Errorf("database schema version %d is not known to this binary", current)
+}
+
+// Helper to run a migration script and record it in tb_SchemaVersion in one transaction.
+// Note that MySQL commits DDL statements immediately.
+func (s *SqlStore) runMigration(ctx context.Context, script string, versionSql string, args ...interface{}) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	for _, stmt := range splitStatements(script) {
+		_, err = tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, s.dialect.rebind(versionSql), args...)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
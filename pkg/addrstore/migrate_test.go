@@ -0,0 +1,118 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	var versions []int
+
+	for _, dialectName := range []string{"sqlite", "mysql", "postgres"} {
+		migrations, err := loadMigrations(dialectName)
+		if err != nil {
+			t.Fatalf("loadMigrations(%s): %v", dialectName, err)
+		}
+
+		for i, m := range migrations {
+			if (m.version != i+1) || (m.up == "") || (m.down == "") {
+				t.Fatalf("%s migration %d_%s is out of sequence or missing a script", dialectName, m.version, m.name)
+			}
+		}
+
+		versions = append(versions, len(migrations))
+	}
+
+	if (versions[0] != versions[1]) || (versions[0] != versions[2]) {
+		t.Fatalf("dialects have different numbers of migrations: %v", versions)
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{"statements and comments", "-- people\nCREATE TABLE tb_A\n(\n    -- id\n    id INT\n);\n\nDROP TABLE tb_B;\n",
+			[]string{"CREATE TABLE tb_A\n(\n    id INT\n)", "DROP TABLE tb_B"}},
+		{"semicolon in a literal", "INSERT INTO tb_A (name) VALUES ('a;b');\nUPDATE tb_A SET name = 'c; d'\nWHERE id = 1;",
+			[]string{"INSERT INTO tb_A (name) VALUES ('a;b')", "UPDATE tb_A SET name = 'c; d'\nWHERE id = 1"}},
+		{"no final semicolon", "DROP TABLE tb_A;\nDROP TABLE tb_B\n", []string{"DROP TABLE tb_A", "DROP TABLE tb_B"}},
+		{"only comments", "-- nothing to do\n", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := splitStatements(test.script); !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("splitStatements: %q", got)
+			}
+		})
+	}
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	store := newTestSqliteStore(t)
+	ctx := context.Background()
+
+	required, err := store.RequiredSchemaVersion()
+	if err != nil {
+		t.Fatalf("RequiredSchemaVersion: %v", err)
+	}
+
+	if err = store.CheckSchemaVersion(ctx); err != nil {
+		t.Fatalf("CheckSchemaVersion after MigrateUp: %v", err)
+	}
+
+	applied, err := store.MigrateUp(ctx)
+	if (err != nil) || (len(applied) != 0) {
+		t.Fatalf("MigrateUp when up to date: %v %v", applied, err)
+	}
+
+	// every down script must undo its up script, so that it can be applied again
+	for version := required; version > 0; version-- {
+		reverted, err := store.MigrateDown(ctx)
+		if (err != nil) || (reverted != version) {
+			t.Fatalf("MigrateDown: %d %v", reverted, err)
+		}
+	}
+
+	if err = store.CheckSchemaVersion(ctx); err == nil {
+		t.Fatalf("CheckSchemaVersion accepted an empty database")
+	}
+
+	reverted, err := store.MigrateDown(ctx)
+	if (err != nil) || (reverted != 0) {
+		t.Fatalf("MigrateDown of an empty database: %d %v", reverted, err)
+	}
+
+	applied, err = store.MigrateUp(ctx)
+	if (err != nil) || (len(applied) != required) {
+		t.Fatalf("MigrateUp after MigrateDown: %v %v", applied, err)
+	}
+
+	status, err := store.MigrationStatus(ctx)
+	if err != nil {
+		t.Fatalf("MigrationStatus: %v", err)
+	}
+
+	for _, m := range status {
+		if !m.Applied {
+			t.Fatalf("migration %d_%s not applied", m.Version, m.Name)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX ix_Party_Modified ON tb_Party;
+DROP INDEX ix_Party_Created ON tb_Party;
+DROP INDEX ix_Party_Company ON tb_Party;
+DROP INDEX ix_Party_LastName ON tb_Party;
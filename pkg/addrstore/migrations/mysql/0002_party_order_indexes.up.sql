@@ -0,0 +1,5 @@
+-- indexes for keyset pagination of get_parties, by sort order
+CREATE INDEX ix_Party_LastName ON tb_Party (inbMserviceId, chvLastName, inbPartyId);
+CREATE INDEX ix_Party_Company ON tb_Party (inbMserviceId, chvCompany, inbPartyId);
+CREATE INDEX ix_Party_Created ON tb_Party (inbMserviceId, dtmCreated, inbPartyId);
+CREATE INDEX ix_Party_Modified ON tb_Party (inbMserviceId, dtmModified, inbPartyId);
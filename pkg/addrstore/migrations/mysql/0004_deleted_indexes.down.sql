@@ -0,0 +1,3 @@
+DROP INDEX ix_Phone_Deleted ON tb_Phone;
+DROP INDEX ix_Address_Deleted ON tb_Address;
+DROP INDEX ix_Party_Deleted ON tb_Party;
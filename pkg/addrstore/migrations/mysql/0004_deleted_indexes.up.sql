@@ -0,0 +1,4 @@
+-- indexes for purging soft deleted rows by deletion date
+CREATE INDEX ix_Party_Deleted ON tb_Party (bitIsDeleted, dtmDeleted);
+CREATE INDEX ix_Address_Deleted ON tb_Address (bitIsDeleted, dtmDeleted);
+CREATE INDEX ix_Phone_Deleted ON tb_Phone (bitIsDeleted, dtmDeleted);
@@ -0,0 +1,101 @@
+-- address book party entity
+CREATE TABLE IF NOT EXISTS tb_Party
+(
+
+    -- party identifier
+    inbPartyId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INTEGER NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- type of party record, int value of PartyType
+    intPartyType INTEGER NOT NULL,
+    -- party last name
+    chvLastName VARCHAR(50) NOT NULL,
+    -- party middle name
+    chvMiddleName VARCHAR(50) NOT NULL,
+    -- party first name
+    chvFirstName VARCHAR(50) NOT NULL,
+    -- party nickname
+    chvNickname VARCHAR(50) NOT NULL,
+    -- party company
+    chvCompany VARCHAR(100) NOT NULL,
+    -- party email
+    chvEmail VARCHAR(50) NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId),
+    UNIQUE (inbMserviceId,inbPartyId)
+);
+
+-- address book address entity
+CREATE TABLE IF NOT EXISTS tb_Address
+(
+
+    -- party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- type of address record, int value of AddressType
+    intAddressType INTEGER NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INTEGER NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- postal address line 1
+    chvAddress1 VARCHAR(100) NOT NULL,
+    -- postal address line 2
+    chvAddress2 VARCHAR(100) NOT NULL,
+    -- postal city
+    chvCity VARCHAR(50) NOT NULL,
+    -- postal state
+    chvState VARCHAR(50) NOT NULL,
+    -- postal code
+    chvPostalCode VARCHAR(20) NOT NULL,
+    -- country code
+    chvCountryCode CHAR(2) NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId,intAddressType)
+);
+
+-- address book phone entity
+CREATE TABLE IF NOT EXISTS tb_Phone
+(
+
+    -- party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- type of phone record, int value of PhoneType
+    intPhoneType INTEGER NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INTEGER NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- phone number
+    chvPhoneNumber VARCHAR(20) NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId,intPhoneType)
+);
@@ -0,0 +1,4 @@
+-- indexes for purging soft deleted rows by deletion date
+CREATE INDEX IF NOT EXISTS ix_Party_Deleted ON tb_Party (bitIsDeleted, dtmDeleted);
+CREATE INDEX IF NOT EXISTS ix_Address_Deleted ON tb_Address (bitIsDeleted, dtmDeleted);
+CREATE INDEX IF NOT EXISTS ix_Phone_Deleted ON tb_Phone (bitIsDeleted, dtmDeleted);
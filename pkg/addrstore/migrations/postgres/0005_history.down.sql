@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS tb_PhoneHistory;
+DROP TABLE IF EXISTS tb_AddressHistory;
+DROP TABLE IF EXISTS tb_PartyHistory;
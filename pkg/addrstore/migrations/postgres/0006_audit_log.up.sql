@@ -0,0 +1,29 @@
+-- audit trail of calls to the service, one row per call
+CREATE TABLE IF NOT EXISTS tb_AuditLog
+(
+
+    -- audit entry identifier
+    inbAuditId BIGSERIAL NOT NULL,
+    -- date of the call
+    dtmLogged TIMESTAMP NOT NULL,
+    -- calling user, the JWT subject or user id
+    chvUserId VARCHAR(100) NOT NULL,
+    -- mservice account identifier, the JWT aid
+    inbMserviceId BIGINT NOT NULL,
+    -- claim level of the caller
+    chvClaim VARCHAR(32) NOT NULL,
+    -- name of the rpc method
+    chvRpc VARCHAR(64) NOT NULL,
+    -- target party identifier, 0 if none
+    inbPartyId BIGINT NOT NULL,
+    -- result code of the call
+    intErrorCode INTEGER NOT NULL,
+
+
+    PRIMARY KEY (inbAuditId)
+);
+
+-- indexes for the filters of query_audit_log
+CREATE INDEX IF NOT EXISTS ix_AuditLog_User ON tb_AuditLog (inbMserviceId, chvUserId, inbAuditId);
+CREATE INDEX IF NOT EXISTS ix_AuditLog_Party ON tb_AuditLog (inbMserviceId, inbPartyId, inbAuditId);
+CREATE INDEX IF NOT EXISTS ix_AuditLog_Logged ON tb_AuditLog (inbMserviceId, dtmLogged);
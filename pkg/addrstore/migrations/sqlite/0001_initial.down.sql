@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS tb_Phone;
+DROP TABLE IF EXISTS tb_Address;
+DROP TABLE IF EXISTS tb_Party;
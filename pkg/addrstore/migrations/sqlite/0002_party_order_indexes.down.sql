@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ix_Party_Modified;
+DROP INDEX IF EXISTS ix_Party_Created;
+DROP INDEX IF EXISTS ix_Party_Company;
+DROP INDEX IF EXISTS ix_Party_LastName;
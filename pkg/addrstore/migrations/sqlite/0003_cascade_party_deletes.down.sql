@@ -0,0 +1 @@
+-- data only migration, the soft deleted addresses and phones are left deleted
@@ -0,0 +1,8 @@
+-- soft delete the live addresses and phones of deleted or missing parties
+UPDATE tb_Address SET dtmDeleted = datetime('now', 'localtime'), intVersion = intVersion + 1, bitIsDeleted = TRUE
+WHERE bitIsDeleted = FALSE AND NOT EXISTS
+(SELECT 1 FROM tb_Party WHERE tb_Party.inbPartyId = tb_Address.inbPartyId AND tb_Party.bitIsDeleted = FALSE);
+
+UPDATE tb_Phone SET dtmDeleted = datetime('now', 'localtime'), intVersion = intVersion + 1, bitIsDeleted = TRUE
+WHERE bitIsDeleted = FALSE AND NOT EXISTS
+(SELECT 1 FROM tb_Party WHERE tb_Party.inbPartyId = tb_Phone.inbPartyId AND tb_Party.bitIsDeleted = FALSE);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_Phone_Deleted;
+DROP INDEX IF EXISTS ix_Address_Deleted;
+DROP INDEX IF EXISTS ix_Party_Deleted;
@@ -0,0 +1,134 @@
+-- address book party versions, one row per change
+CREATE TABLE IF NOT EXISTS tb_PartyHistory
+(
+
+    -- party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- type of party record, int value of PartyType
+    intPartyType INT NOT NULL,
+    -- party last name
+    chvLastName VARCHAR(50) NOT NULL,
+    -- party middle name
+    chvMiddleName VARCHAR(50) NOT NULL,
+    -- party first name
+    chvFirstName VARCHAR(50) NOT NULL,
+    -- party nickname
+    chvNickname VARCHAR(50) NOT NULL,
+    -- party company
+    chvCompany VARCHAR(100) NOT NULL,
+    -- party email
+    chvEmail VARCHAR(50) NOT NULL,
+    -- date the version was recorded
+    dtmChanged DATETIME NOT NULL,
+    -- user that made the change, from the JWT
+    chvChangedBy VARCHAR(100) NOT NULL,
+    -- number of the change in the party history, shared by the records changed together
+    intSequence INT NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId,intVersion)
+);
+
+-- address book address versions, one row per change
+CREATE TABLE IF NOT EXISTS tb_AddressHistory
+(
+
+    -- party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- type of address record, int value of AddressType
+    intAddressType INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- postal address line 1
+    chvAddress1 VARCHAR(100) NOT NULL,
+    -- postal address line 2
+    chvAddress2 VARCHAR(100) NOT NULL,
+    -- postal city
+    chvCity VARCHAR(50) NOT NULL,
+    -- postal state
+    chvState VARCHAR(50) NOT NULL,
+    -- postal code
+    chvPostalCode VARCHAR(20) NOT NULL,
+    -- country code
+    chvCountryCode CHAR(2) NOT NULL,
+    -- date the version was recorded
+    dtmChanged DATETIME NOT NULL,
+    -- user that made the change, from the JWT
+    chvChangedBy VARCHAR(100) NOT NULL,
+    -- number of the change in the party history, shared by the records changed together
+    intSequence INT NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId,intAddressType,intVersion)
+);
+
+-- address book phone versions, one row per change
+CREATE TABLE IF NOT EXISTS tb_PhoneHistory
+(
+
+    -- party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- type of phone record, int value of PhoneType
+    intPhoneType INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- phone number
+    chvPhoneNumber VARCHAR(20) NOT NULL,
+    -- date the version was recorded
+    dtmChanged DATETIME NOT NULL,
+    -- user that made the change, from the JWT
+    chvChangedBy VARCHAR(100) NOT NULL,
+    -- number of the change in the party history, shared by the records changed together
+    intSequence INT NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId,intPhoneType,intVersion)
+);
+
+-- the current version of every record starts its history, as change 0
+INSERT INTO tb_PartyHistory (inbPartyId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, intPartyType, chvLastName, chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail, dtmChanged, chvChangedBy, intSequence)
+SELECT inbPartyId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, intPartyType, chvLastName, chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail,
+    CASE WHEN bitIsDeleted THEN dtmDeleted ELSE dtmModified END, '', 0
+FROM tb_Party;
+
+INSERT INTO tb_AddressHistory (inbPartyId, intAddressType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvAddress1, chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode, dtmChanged, chvChangedBy, intSequence)
+SELECT inbPartyId, intAddressType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvAddress1, chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode,
+    CASE WHEN bitIsDeleted THEN dtmDeleted ELSE dtmModified END, '', 0
+FROM tb_Address;
+
+INSERT INTO tb_PhoneHistory (inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvPhoneNumber, dtmChanged, chvChangedBy, intSequence)
+SELECT inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvPhoneNumber,
+    CASE WHEN bitIsDeleted THEN dtmDeleted ELSE dtmModified END, '', 0
+FROM tb_Phone;
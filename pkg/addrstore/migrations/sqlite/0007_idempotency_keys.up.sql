@@ -0,0 +1,25 @@
+-- responses of create calls made with an idempotency key, kept to replay for repeats of the call
+CREATE TABLE IF NOT EXISTS tb_IdempotencyKey
+(
+
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- idempotency key sent by the client
+    chvKey VARCHAR(128) NOT NULL,
+    -- date the key was first used
+    dtmCreated DATETIME NOT NULL,
+    -- date after which the key may be used again
+    dtmExpires DATETIME NOT NULL,
+    -- name of the rpc method
+    chvRpc VARCHAR(64) NOT NULL,
+    -- hash of the request
+    chvRequestHash VARCHAR(64) NOT NULL,
+    -- response of the call, as protobuf JSON
+    txtResponse TEXT NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,chvKey)
+);
+
+-- index for purging expired keys
+CREATE INDEX IF NOT EXISTS ix_IdempotencyKey_Expires ON tb_IdempotencyKey (dtmExpires);
@@ -0,0 +1,31 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"database/sql"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+var mysqlDialect = &dialect{
+	name:         "mysql",
+	now:          "NOW()",
+	insertIgnore: true,
+}
+
+// Get a new Store for a MySQL / MariaDB connection, using the tables in migrations/mysql.
+func NewMysqlStore(sqlDB *sql.DB) *SqlStore {
+	return newSqlStore(sqlDB, mysqlDialect)
+}
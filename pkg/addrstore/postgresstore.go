@@ -0,0 +1,32 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"database/sql"
+
+	_ "github.com/lib/pq"
+)
+
+var postgresDialect = &dialect{
+	name:      "postgres",
+	now:       "LOCALTIMESTAMP(0)",
+	numbered:  true,
+	returning: true,
+}
+
+// Get a new Store for a PostgreSQL connection, using the tables in migrations/postgres.
+func NewPostgresStore(sqlDB *sql.DB) *SqlStore {
+	return newSqlStore(sqlDB, postgresDialect)
+}
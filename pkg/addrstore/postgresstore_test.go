@@ -0,0 +1,238 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Environment variable with the DSN of a scratch PostgreSQL database for the store tests; its
+// addrbook tables are dropped and created again for each test.
+const postgresDsnEnv = "ADDRBOOK_TEST_POSTGRES_DSN"
+
+// Get a PostgreSQL store over the scratch database with freshly migrated tables, closed when the test
+// ends, or skip the test if there is no scratch database.
+func newTestPostgresStore(t *testing.T) *SqlStore {
+	t.Helper()
+
+	dsn := os.Getenv(postgresDsnEnv)
+	if dsn == "" {
+		t.Skip(postgresDsnEnv + " is not set")
+	}
+
+	sqlDB, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+
+	store := NewPostgresStore(sqlDB)
+	t.Cleanup(func() { store.Close() })
+
+	ctx := context.Background()
+
+	for {
+		reverted, err := store.MigrateDown(ctx)
+		if err != nil {
+			t.Fatalf("MigrateDown: %v", err)
+		}
+
+		if reverted == 0 {
+			break
+		}
+	}
+
+	if _, err = store.MigrateUp(ctx); err != nil {
+		t.Fatalf("MigrateUp: %v", err)
+	}
+
+	return store
+}
+
+func TestRebind(t *testing.T) {
+	sqlstring := "UPDATE tb_Party SET dtmModified = NOW(), intVersion = ? WHERE inbPartyId = ? AND intVersion = ?"
+
+	tests := []struct {
+		dialect *dialect
+		want    string
+	}{
+		{mysqlDialect, sqlstring},
+		{sqliteDialect, "UPDATE tb_Party SET dtmModified = datetime('now', 'localtime'), intVersion = ? " +
+			"WHERE inbPartyId = ? AND intVersion = ?"},
+		{postgresDialect, "UPDATE tb_Party SET dtmModified = LOCALTIMESTAMP(0), intVersion = $1 " +
+			"WHERE inbPartyId = $2 AND intVersion = $3"},
+	}
+
+	for _, test := range tests {
+		if got := test.dialect.rebind(sqlstring); got != test.want {
+			t.Errorf("%s: %s", test.dialect.name, got)
+		}
+	}
+
+	// placeholders past $9 take two digits
+	got := postgresDialect.rebind(strings.Repeat("?,", 11) + "?")
+	if !strings.HasSuffix(got, "$10,$11,$12") {
+		t.Fatalf("rebind of 12 placeholders: %s", got)
+	}
+}
+
+// The statements prepared on recorder connections, and the rows affected by each exec.
+var recorded struct {
+	statements   []string
+	rowsAffected int64
+}
+
+// Driver that records the statements prepared on it instead of running them. Each exec affects
+// recorded.rowsAffected rows, and each query returns a single row with the single value 42.
+type recorderDriver struct{}
+
+type recorderConn struct{}
+
+type recorderStmt struct{}
+
+type recorderRows struct {
+	done bool
+}
+
+func (recorderDriver) Open(name string) (driver.Conn, error) {
+	return recorderConn{}, nil
+}
+
+func (recorderConn) Prepare(query string) (driver.Stmt, error) {
+	recorded.statements = append(recorded.statements, query)
+	return recorderStmt{}, nil
+}
+
+func (recorderConn) Close() error {
+	return nil
+}
+
+func (recorderConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("recorder has no transactions")
+}
+
+func (recorderStmt) Close() error {
+	return nil
+}
+
+func (recorderStmt) NumInput() int {
+	return -1
+}
+
+func (recorderStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(recorded.rowsAffected), nil
+}
+
+func (recorderStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &recorderRows{}, nil
+}
+
+func (*recorderRows) Columns() []string {
+	return []string{"id"}
+}
+
+func (*recorderRows) Close() error {
+	return nil
+}
+
+func (r *recorderRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	dest[0] = int64(42)
+
+	return nil
+}
+
+func init() {
+	sql.Register("addrstore-recorder", recorderDriver{})
+}
+
+func TestPostgresStatements(t *testing.T) {
+	sqlDB, err := sql.Open("addrstore-recorder", "")
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+
+	store := NewPostgresStore(sqlDB)
+	defer store.Close()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		call         func() error
+		want         []string
+	}{
+		{"party id from RETURNING", 1, func() error {
+			partyId, err := store.CreateParty(ctx, &pb.Party{MserviceId: 23, PartyType: 1, LastName: "Baggins"})
+			if (err == nil) && (partyId != 42) {
+				err = errors.New("party id is not the one returned")
+			}
+			return err
+		}, []string{"VALUES (LOCALTIMESTAMP(0), LOCALTIMESTAMP(0), LOCALTIMESTAMP(0), FALSE, 1, $1, $2, $3, $4, $5, $6, $7, $8)",
+			" RETURNING inbPartyId"}},
+		{"versioned update", 1, func() error {
+			version, err := store.UpdateParty(ctx, &pb.Party{MserviceId: 23, PartyId: 42, Version: 1})
+			if (err == nil) && (version != 2) {
+				err = errors.New("version is not bumped")
+			}
+			return err
+		}, []string{"dtmModified = LOCALTIMESTAMP(0), intVersion = $1", "AND intVersion = $11"}},
+		{"duplicate skipped", 0, func() error {
+			err := store.insertUnique(ctx, "INSERT INTO tb_Phone (inbPartyId, intPhoneType) VALUES (?, ?)", 42, 3)
+			if err != ErrConflict {
+				return errors.New("duplicate is not a conflict")
+			}
+			return nil
+		}, []string{"INSERT INTO tb_Phone (inbPartyId, intPhoneType) VALUES ($1, $2) ON CONFLICT DO NOTHING"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorded.statements = nil
+			recorded.rowsAffected = test.rowsAffected
+
+			if err := test.call(); err != nil {
+				t.Fatalf("%v", err)
+			}
+
+			if len(recorded.statements) != 1 {
+				t.Fatalf("statements: %q", recorded.statements)
+			}
+
+			statement := recorded.statements[0]
+			for _, want := range test.want {
+				if !strings.Contains(statement, want) {
+					t.Fatalf("statement %q does not have %q", statement, want)
+				}
+			}
+
+			if strings.ContainsAny(statement, "?") || strings.Contains(statement, "NOW()") {
+				t.Fatalf("statement %q is not rebound", statement)
+			}
+		})
+	}
+}
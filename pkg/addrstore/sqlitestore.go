@@ -0,0 +1,52 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"database/sql"
+
+	_ "modernc.org/sqlite"
+)
+
+var sqliteDialect = &dialect{
+	name: "sqlite",
+	now:  "datetime('now', 'localtime')",
+}
+
+// Open the SQLite database file at dbPath, creating it if needed. The pool has a single connection,
+// so that calls take turns: SQLite has one writer, and a deferred transaction that reads and then
+// writes could otherwise fail with SQLITE_BUSY while another connection writes.
+func OpenSqliteDatabase(dbPath string) (*sql.DB, error) {
+	dsn := "file:" + dbPath + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+
+	sqlDB, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB.SetMaxOpenConns(1)
+
+	err = sqlDB.Ping()
+	if err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+
+	return sqlDB, nil
+}
+
+// Get a new Store for a SQLite connection, using the tables in migrations/sqlite.
+func NewSqliteStore(sqlDB *sql.DB) *SqlStore {
+	return newSqlStore(sqlDB, sqliteDialect)
+}
@@ -0,0 +1,1623 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrstore
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// SqlStore is the database/sql implementation of Store, shared by the relational backends.
+// Statements are written in MySQL syntax and adjusted for the backend by its dialect.
+type SqlStore struct {
+	db      *sql.DB
+	q       queryer
+	dialect *dialect
+}
+
+// The statement methods shared by sql.DB and sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Differences between the SQL accepted by the supported databases.
+type dialect struct {
+	// name of the backend, as used in the addrserver db_driver setting
+	name string
+	// expression for the current local date and time
+	now string
+	// use numbered $1, $2, ... placeholders instead of ?
+	numbered bool
+	// use INSERT ... RETURNING for generated identifiers instead of LastInsertId
+	returning bool
+	// skip duplicate keys with INSERT IGNORE instead of ON CONFLICT DO NOTHING
+	insertIgnore bool
+}
+
+// Rewrite a statement written in MySQL syntax for this dialect.
+func (d *dialect) rebind(sqlstring string) string {
+	if d.now != "NOW()" {
+		sqlstring = strings.ReplaceAll(sqlstring, "NOW()", d.now)
+	}
+
+	if d.numbered {
+		var sb strings.Builder
+		n := 0
+		for _, c := range sqlstring {
+			if c == '?' {
+				n++
+				sb.WriteString("$" + strconv.Itoa(n))
+			} else {
+				sb.WriteRune(c)
+			}
+		}
+		sqlstring = sb.String()
+	}
+
+	return sqlstring
+}
+
+// Rewrite an INSERT ... VALUES statement to skip a row with a duplicate key instead of failing.
+func (d *dialect) ignoreDuplicates(sqlstring string) string {
+	if d.insertIgnore {
+		return strings.Replace(sqlstring, "INSERT INTO", "INSERT IGNORE INTO", 1)
+	}
+
+	return sqlstring + " ON CONFLICT DO NOTHING"
+}
+
+// Get a new SqlStore instance for an open database connection.
+func newSqlStore(sqlDB *sql.DB, d *dialect) *SqlStore {
+	store := SqlStore{}
+	store.db = sqlDB
+	store.q = sqlDB
+	store.dialect = d
+	return &store
+}
+
+// Get the underlying database connection.
+func (s *SqlStore) DB() *sql.DB {
+	return s.db
+}
+
+// Close the underlying database connection.
+func (s *SqlStore) Close() error {
+	return s.db.Close()
+}
+
+// Helper to prepare a statement for this dialect.
+func (s *SqlStore) prepare(ctx context.Context, sqlstring string) (*sql.Stmt, error) {
+	return s.q.PrepareContext(ctx, s.dialect.rebind(sqlstring))
+}
+
+// run fn in a database transaction, committing if fn returns nil and rolling back otherwise
+func (s *SqlStore) InTransaction(ctx context.Context, fn func(tx Store) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	txStore := SqlStore{db: s.db, q: tx, dialect: s.dialect}
+
+	err = fn(&txStore)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// hard delete up to limit deleted rows from each of the party, address and phone tables whose deletion
+// date is before deletedBefore, with their history, for one mservice account or all if mserviceId
+// is 0, returning the rows removed by account
+func (s *SqlStore) PurgeDeleted(ctx context.Context, mserviceId int64, deletedBefore time.Time,
+	limit int) ([]*PurgeCount, error) {
+	counts := make(purgeCounts)
+	cutoff := formatCursorTime(deletedBefore)
+
+	// a purged party takes the history of its addresses and phones with it, while a purged address
+	// or phone of a live party keeps its history
+	err := s.purgeTable(ctx, "tb_Party", "", partyHistoryTables, mserviceId, cutoff, limit, func(msid int64) {
+		counts.get(msid).Parties++
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.purgeTable(ctx, "tb_Address", "intAddressType", nil, mserviceId, cutoff, limit, func(msid int64) {
+		counts.get(msid).Addresses++
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.purgeTable(ctx, "tb_Phone", "intPhoneType", nil, mserviceId, cutoff, limit, func(msid int64) {
+		counts.get(msid).Phones++
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts.list(), nil
+}
+
+// History tables holding the versions of a party and its addresses and phones.
+var partyHistoryTables = []string{"tb_PartyHistory", "tb_AddressHistory", "tb_PhoneHistory"}
+
+// Helper to hard delete up to limit deleted rows of a table, oldest deletion first, with the rows of
+// their party in historyTables, calling removed with the mservice account of each; typeColumn is
+// empty for tb_Party.
+func (s *SqlStore) purgeTable(ctx context.Context, table string, typeColumn string, historyTables []string,
+	mserviceId int64, cutoff string, limit int, removed func(mserviceId int64)) error {
+	type rowKey struct {
+		mserviceId int64
+		partyId    int64
+		childType  int32
+	}
+
+	var sb strings.Builder
+	var args []interface{}
+
+	typeExpr := typeColumn
+	if typeExpr == "" {
+		typeExpr = "0"
+	}
+
+	sb.WriteString("SELECT inbMserviceId, inbPartyId, " + typeExpr + " FROM " + table +
+		" WHERE bitIsDeleted = TRUE AND dtmDeleted < ?")
+	args = append(args, cutoff)
+
+	if mserviceId != 0 {
+		sb.WriteString(" AND inbMserviceId = ?")
+		args = append(args, mserviceId)
+	}
+
+	sb.WriteString(" ORDER BY dtmDeleted LIMIT ?")
+	args = append(args, limit)
+
+	stmt, err := s.prepare(ctx, sb.String())
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return err
+	}
+
+	var keys []rowKey
+
+	for rows.Next() {
+		var key rowKey
+		err = rows.Scan(&key.mserviceId, &key.partyId, &key.childType)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		keys = append(keys, key)
+	}
+
+	// read all keys before deleting, as the sqlite pool has a single connection
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	var purged []rowKey
+
+	// remove each row with any history, in one transaction per batch
+	err = s.InTransaction(ctx, func(tx Store) error {
+		txStore := tx.(*SqlStore)
+
+		sqlstring := "DELETE FROM " + table + " WHERE inbPartyId = ? AND " + typeExpr +
+			" = ? AND bitIsDeleted = TRUE AND dtmDeleted < ?"
+
+		delStmt, err := txStore.prepare(ctx, sqlstring)
+		if err != nil {
+			return err
+		}
+
+		defer delStmt.Close()
+
+		var historyStmts []*sql.Stmt
+
+		for _, historyTable := range historyTables {
+			historyStmt, err := txStore.prepare(ctx, "DELETE FROM "+historyTable+" WHERE inbPartyId = ?")
+			if err != nil {
+				return err
+			}
+
+			defer historyStmt.Close()
+
+			historyStmts = append(historyStmts, historyStmt)
+		}
+
+		for _, key := range keys {
+			res, err := delStmt.ExecContext(ctx, key.partyId, key.childType, cutoff)
+			if err != nil {
+				return err
+			}
+
+			if rowsAffected, _ := res.RowsAffected(); rowsAffected != 1 {
+				continue
+			}
+
+			for _, historyStmt := range historyStmts {
+				_, err = historyStmt.ExecContext(ctx, key.partyId)
+				if err != nil {
+					return err
+				}
+			}
+
+			purged = append(purged, key)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, key := range purged {
+		removed(key.mserviceId)
+	}
+
+	return nil
+}
+
+// Helper to get the version of a new address or phone in an empty (party, type) slot, following
+// any versions left in its history by a purged record, so that their history is not mixed up.
+func (s *SqlStore) getFirstChildVersion(ctx context.Context, table string, typeColumn string, partyId int64,
+	childType int32) (int32, error) {
+	sqlstring := "SELECT COALESCE(MAX(intVersion), 0) + 1 FROM " + table + "History WHERE inbPartyId = ? AND " +
+		typeColumn + " = ?"
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	var version int32
+	err = stmt.QueryRowContext(ctx, partyId, childType).Scan(&version)
+	return version, err
+}
+
+// Helper to get the deleted flag and version of the address or phone row in a (party, type)
+// slot, returning sql.ErrNoRows if the slot is empty.
+func (s *SqlStore) getChildSlot(ctx context.Context, table string, typeColumn string, partyId int64,
+	childType int32) (bool, int32, error) {
+	sqlstring := "SELECT bitIsDeleted, intVersion FROM " + table + " WHERE inbPartyId = ? AND " + typeColumn + " = ?"
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return false, 0, err
+	}
+
+	defer stmt.Close()
+
+	var deleted bool
+	var version int32
+
+	err = stmt.QueryRowContext(ctx, partyId, childType).Scan(&deleted, &version)
+	return deleted, version, err
+}
+
+// Helper to insert a single row, returning ErrConflict if a row with the same key exists.
+// The duplicate is skipped rather than failing the statement, which would abort the transaction
+// on PostgreSQL, and is detected without reading the row, which a MySQL transaction may not see.
+func (s *SqlStore) insertUnique(ctx context.Context, sqlstring string, args ...interface{}) error {
+	stmt, err := s.prepare(ctx, s.dialect.ignoreDuplicates(sqlstring))
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrConflict
+	}
+
+	return nil
+}
+
+// Helper to insert rows in one statement, repeating the values list for each row.
+func (s *SqlStore) insertRows(ctx context.Context, sqlstring string, values string, rows [][]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	var args []interface{}
+
+	sb.WriteString(sqlstring)
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(values)
+		args = append(args, row...)
+	}
+
+	stmt, err := s.prepare(ctx, sb.String())
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, args...)
+	return err
+}
+
+// Helper to execute a versioned update or delete, returning the new version.
+func (s *SqlStore) execVersioned(ctx context.Context, sqlstring string, version int32, args ...interface{}) (int32, error) {
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		return 0, ErrNotFound
+	}
+
+	return version + 1, nil
+}
+
+// Helper to insert a row and get its generated identifier column.
+func (s *SqlStore) insertReturningId(ctx context.Context, sqlstring string, idColumn string,
+	args ...interface{}) (int64, error) {
+	if s.dialect.returning {
+		sqlstring = sqlstring + " RETURNING " + idColumn
+	}
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	if s.dialect.returning {
+		var id int64
+		err = stmt.QueryRowContext(ctx, args...).Scan(&id)
+		return id, err
+	}
+
+	res, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+// create new party
+func (s *SqlStore) CreateParty(ctx context.Context, party *pb.Party) (int64, error) {
+	sqlstring := `INSERT INTO tb_Party
+      (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, intPartyType, chvLastName,
+      chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail)
+      VALUES (NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	return s.insertReturningId(ctx, sqlstring, "inbPartyId", party.GetMserviceId(), party.GetPartyType(),
+		party.GetLastName(), party.GetMiddleName(), party.GetFirstName(), party.GetNickname(), party.GetCompany(),
+		party.GetEmail())
+}
+
+// update an existing party
+func (s *SqlStore) UpdateParty(ctx context.Context, party *pb.Party) (int32, error) {
+	sqlstring := `UPDATE tb_Party SET dtmModified = NOW(), intVersion = ?, intPartyType = ?, chvLastName = ?,
+    chvMiddleName = ?, chvFirstName = ?, chvNickname = ?, chvCompany = ?, chvEmail= ?
+    WHERE inbMserviceId = ? AND inbPartyId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	return s.execVersioned(ctx, sqlstring, party.GetVersion(), party.GetVersion()+1, party.GetPartyType(),
+		party.GetLastName(), party.GetMiddleName(), party.GetFirstName(), party.GetNickname(), party.GetCompany(),
+		party.GetEmail(), party.GetMserviceId(), party.GetPartyId(), party.GetVersion())
+}
+
+// delete an existing party
+func (s *SqlStore) DeleteParty(ctx context.Context, mserviceId int64, partyId int64, version int32) (int32, error) {
+	sqlstring := `UPDATE tb_Party SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+    WHERE inbMserviceId = ? AND inbPartyId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	return s.execVersioned(ctx, sqlstring, version, version+1, mserviceId, partyId, version)
+}
+
+// get party by id
+func (s *SqlStore) GetParty(ctx context.Context, mserviceId int64, partyId int64) (*pb.Party, error) {
+	sqlstring := `SELECT inbPartyId, dtmCreated, dtmModified, intVersion, inbMserviceId, intPartyType, chvLastName,
+	chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail FROM tb_Party WHERE inbMserviceId = ? AND
+	inbPartyId = ? AND bitIsDeleted = FALSE`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	party, err := scanParty(stmt.QueryRowContext(ctx, mserviceId, partyId))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+
+	return party, err
+}
+
+// Sort columns for GetParties, by order.
+var partyOrderColumns = map[string]string{
+	OrderByLastName: "chvLastName",
+	OrderByCompany:  "chvCompany",
+	OrderByCreated:  "dtmCreated",
+	OrderByModified: "dtmModified",
+}
+
+// get parties by mservice id, in orderBy order starting after the cursor (nil for the first page),
+// returning at most limit parties (0 for all)
+func (s *SqlStore) GetParties(ctx context.Context, mserviceId int64, orderBy string, after *PartyCursor, limit int) ([]*pb.Party, error) {
+	var sb strings.Builder
+	var args []interface{}
+
+	sb.WriteString(`SELECT inbPartyId, dtmCreated, dtmModified, intVersion, inbMserviceId, intPartyType, chvLastName,
+	chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail FROM tb_Party WHERE inbMserviceId = ? AND
+	bitIsDeleted = FALSE`)
+	args = append(args, mserviceId)
+
+	column, ok := partyOrderColumns[orderBy]
+
+	if after != nil {
+		if ok {
+			sb.WriteString(" AND (" + column + " > ? OR (" + column + " = ? AND inbPartyId > ?))")
+			args = append(args, after.Value, after.Value, after.PartyId)
+		} else {
+			sb.WriteString(" AND inbPartyId > ?")
+			args = append(args, after.PartyId)
+		}
+	}
+
+	if ok {
+		sb.WriteString(" ORDER BY " + column + ", inbPartyId")
+	} else {
+		sb.WriteString(" ORDER BY inbPartyId")
+	}
+
+	if limit > 0 {
+		sb.WriteString(" LIMIT ?")
+		args = append(args, limit)
+	}
+
+	stmt, err := s.prepare(ctx, sb.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var parties []*pb.Party
+
+	for rows.Next() {
+		party, err := scanParty(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		parties = append(parties, party)
+	}
+
+	return parties, rows.Err()
+}
+
+// search parties by the non-empty fields of the request
+func (s *SqlStore) SearchParties(ctx context.Context, filter *pb.SearchPartiesRequest) ([]*pb.Party, error) {
+	var sb strings.Builder
+	var args []interface{}
+
+	sb.WriteString(`SELECT p.inbPartyId, p.dtmCreated, p.dtmModified, p.intVersion, p.inbMserviceId, p.intPartyType,
+	p.chvLastName, p.chvMiddleName, p.chvFirstName, p.chvNickname, p.chvCompany, p.chvEmail FROM tb_Party p
+	WHERE p.inbMserviceId = ? AND p.bitIsDeleted = FALSE`)
+	args = append(args, filter.GetMserviceId())
+
+	if filter.GetLastName() != "" {
+		sb.WriteString(" AND LOWER(p.chvLastName) LIKE ? ESCAPE '!'")
+		args = append(args, likePrefix(filter.GetLastName()))
+	}
+
+	if filter.GetFirstName() != "" {
+		sb.WriteString(" AND LOWER(p.chvFirstName) LIKE ? ESCAPE '!'")
+		args = append(args, likePrefix(filter.GetFirstName()))
+	}
+
+	if filter.GetCompany() != "" {
+		sb.WriteString(" AND LOWER(p.chvCompany) = ?")
+		args = append(args, strings.ToLower(filter.GetCompany()))
+	}
+
+	if filter.GetEmail() != "" {
+		sb.WriteString(" AND LOWER(p.chvEmail) = ?")
+		args = append(args, strings.ToLower(filter.GetEmail()))
+	}
+
+	if filter.GetPartyType() != 0 {
+		sb.WriteString(" AND p.intPartyType = ?")
+		args = append(args, filter.GetPartyType())
+	}
+
+	if filter.GetPhoneNumber() != "" {
+		sb.WriteString(` AND EXISTS (SELECT 1 FROM tb_Phone ph WHERE ph.inbMserviceId = p.inbMserviceId AND
+	ph.inbPartyId = p.inbPartyId AND ph.bitIsDeleted = FALSE AND ph.chvPhoneNumber = ?)`)
+		args = append(args, filter.GetPhoneNumber())
+	}
+
+	if (filter.GetCity() != "") || (filter.GetState() != "") || (filter.GetPostalCode() != "") {
+		sb.WriteString(` AND EXISTS (SELECT 1 FROM tb_Address a WHERE a.inbMserviceId = p.inbMserviceId AND
+	a.inbPartyId = p.inbPartyId AND a.bitIsDeleted = FALSE`)
+
+		if filter.GetCity() != "" {
+			sb.WriteString(" AND LOWER(a.chvCity) = ?")
+			args = append(args, strings.ToLower(filter.GetCity()))
+		}
+
+		if filter.GetState() != "" {
+			sb.WriteString(" AND LOWER(a.chvState) = ?")
+			args = append(args, strings.ToLower(filter.GetState()))
+		}
+
+		if filter.GetPostalCode() != "" {
+			sb.WriteString(" AND a.chvPostalCode = ?")
+			args = append(args, filter.GetPostalCode())
+		}
+
+		sb.WriteString(")")
+	}
+
+	sb.WriteString(" ORDER BY p.inbPartyId")
+
+	stmt, err := s.prepare(ctx, sb.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var parties []*pb.Party
+
+	for rows.Next() {
+		party, err := scanParty(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		parties = append(parties, party)
+	}
+
+	return parties, rows.Err()
+}
+
+// create a new address for a party, reviving a deleted address of the same type with a fresh version
+func (s *SqlStore) CreateAddress(ctx context.Context, addr *pb.Address) (int32, error) {
+	deleted, version, err := s.getChildSlot(ctx, "tb_Address", "intAddressType", addr.GetPartyId(), addr.GetAddressType())
+
+	if err == sql.ErrNoRows {
+		version, err = s.getFirstChildVersion(ctx, "tb_Address", "intAddressType", addr.GetPartyId(),
+			addr.GetAddressType())
+		if err != nil {
+			return 0, err
+		}
+
+		sqlstring := `INSERT INTO tb_Address
+	(inbPartyId, intAddressType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+    chvAddress1, chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode) VALUES
+    (?, ?, NOW(), NOW(), NOW(), FALSE, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+		// a concurrent create may fill the slot first
+		err = s.insertUnique(ctx, sqlstring, addr.GetPartyId(), addr.GetAddressType(), version, addr.GetMserviceId(),
+			addr.GetAddress_1(), addr.GetAddress_2(), addr.GetCity(), addr.GetState(), addr.GetPostalCode(),
+			addr.GetCountryCode())
+		if err != nil {
+			return 0, err
+		}
+
+		return version, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !deleted {
+		return 0, ErrConflict
+	}
+
+	sqlstring := `UPDATE tb_Address SET dtmCreated = NOW(), dtmModified = NOW(), dtmDeleted = NOW(), bitIsDeleted = FALSE,
+    intVersion = ?, inbMserviceId = ?, chvAddress1 = ?, chvAddress2 = ?, chvCity = ?, chvState = ?, chvPostalCode = ?,
+    chvCountryCode = ? WHERE inbPartyId = ? AND intAddressType = ? AND intVersion = ? AND bitIsDeleted = TRUE`
+
+	version, err = s.execVersioned(ctx, sqlstring, version, version+1, addr.GetMserviceId(), addr.GetAddress_1(),
+		addr.GetAddress_2(), addr.GetCity(), addr.GetState(), addr.GetPostalCode(), addr.GetCountryCode(),
+		addr.GetPartyId(), addr.GetAddressType(), version)
+	if err == ErrNotFound {
+		// revived or changed since getChildSlot
+		return 0, ErrConflict
+	}
+
+	return version, err
+}
+
+// get deleted parties by mservice id, most recently deleted first
+func (s *SqlStore) GetDeletedParties(ctx context.Context, mserviceId int64) ([]*pb.Party, error) {
+	sqlstring := `SELECT inbPartyId, dtmCreated, dtmModified, intVersion, inbMserviceId, intPartyType, chvLastName,
+	chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail, dtmDeleted FROM tb_Party WHERE inbMserviceId = ? AND
+	bitIsDeleted = TRUE ORDER BY dtmDeleted DESC, inbPartyId`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, mserviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var parties []*pb.Party
+
+	for rows.Next() {
+		party, err := scanDeletedParty(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		parties = append(parties, party)
+	}
+
+	return parties, rows.Err()
+}
+
+// restore a deleted party
+func (s *SqlStore) RestoreParty(ctx context.Context, mserviceId int64, partyId int64, version int32) (int32, error) {
+	sqlstring := `UPDATE tb_Party SET dtmModified = NOW(), intVersion = ?, bitIsDeleted = FALSE
+    WHERE inbMserviceId = ? AND inbPartyId = ? AND intVersion = ? AND bitIsDeleted = TRUE`
+
+	return s.execVersioned(ctx, sqlstring, version, version+1, mserviceId, partyId, version)
+}
+
+// create new addresses for parties in one statement, in empty slots; all start at version 1
+func (s *SqlStore) CreateAddresses(ctx context.Context, addrs []*pb.Address) error {
+	sqlstring := `INSERT INTO tb_Address
+	(inbPartyId, intAddressType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+    chvAddress1, chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode) VALUES `
+
+	var rows [][]interface{}
+	for _, addr := range addrs {
+		rows = append(rows, []interface{}{addr.GetPartyId(), addr.GetAddressType(), addr.GetMserviceId(),
+			addr.GetAddress_1(), addr.GetAddress_2(), addr.GetCity(), addr.GetState(), addr.GetPostalCode(),
+			addr.GetCountryCode()})
+	}
+
+	return s.insertRows(ctx, sqlstring, "(?, ?, NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?, ?, ?)", rows)
+}
+
+// update an existing address for a party
+func (s *SqlStore) UpdateAddress(ctx context.Context, addr *pb.Address) (int32, error) {
+	sqlstring := `UPDATE tb_Address SET dtmModified = NOW(), intVersion = ?, chvAddress1 = ?, chvAddress2 = ?,
+    chvCity = ?, chvState = ?, chvPostalCode= ?, chvCountryCode = ? WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND intAddressType = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	return s.execVersioned(ctx, sqlstring, addr.GetVersion(), addr.GetVersion()+1, addr.GetAddress_1(),
+		addr.GetAddress_2(), addr.GetCity(), addr.GetState(), addr.GetPostalCode(), addr.GetCountryCode(),
+		addr.GetMserviceId(), addr.GetPartyId(), addr.GetAddressType(), addr.GetVersion())
+}
+
+// delete an existing address for a party
+func (s *SqlStore) DeleteAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32,
+	version int32) (int32, error) {
+	sqlstring := `UPDATE tb_Address SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND intAddressType = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	return s.execVersioned(ctx, sqlstring, version, version+1, mserviceId, partyId, addressType, version)
+}
+
+// delete all live addresses for a party, bumping their versions
+func (s *SqlStore) DeleteAddresses(ctx context.Context, mserviceId int64, partyId int64) error {
+	sqlstring := `UPDATE tb_Address SET dtmDeleted = NOW(), intVersion = intVersion + 1, bitIsDeleted = TRUE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = FALSE`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, mserviceId, partyId)
+	return err
+}
+
+// restore a deleted address for a party
+func (s *SqlStore) RestoreAddress(ctx context.Context, mserviceId int64, partyId int64, addressType int32,
+	version int32) (int32, error) {
+	sqlstring := `UPDATE tb_Address SET dtmModified = NOW(), intVersion = ?, bitIsDeleted = FALSE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND intAddressType = ? AND intVersion = ? AND bitIsDeleted = TRUE`
+
+	return s.execVersioned(ctx, sqlstring, version, version+1, mserviceId, partyId, addressType, version)
+}
+
+// restore the addresses deleted with a party, at or after its deletion, bumping their versions
+func (s *SqlStore) RestoreAddresses(ctx context.Context, mserviceId int64, partyId int64) error {
+	sqlstring := `UPDATE tb_Address SET dtmModified = NOW(), intVersion = intVersion + 1, bitIsDeleted = FALSE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = TRUE AND
+    dtmDeleted >= (SELECT dtmDeleted FROM tb_Party WHERE inbPartyId = ?)`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, mserviceId, partyId, partyId)
+	return err
+}
+
+// get an address for a party by type
+func (s *SqlStore) GetAddress(ctx context.Context, mserviceId int64, partyId int64,
+	addressType int32) (*pb.Address, error) {
+	sqlstring := `SELECT inbPartyId, intAddressType, dtmCreated, dtmModified, intVersion, inbMserviceId, chvAddress1,
+    chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode FROM tb_Address WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND intAddressType = ? AND bitIsDeleted = FALSE`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	addr, err := scanAddress(stmt.QueryRowContext(ctx, mserviceId, partyId, addressType))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+
+	return addr, err
+}
+
+// get all addresses for a party
+func (s *SqlStore) GetAddresses(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.Address, error) {
+	sqlstring := `SELECT inbPartyId, intAddressType, dtmCreated, dtmModified, intVersion, inbMserviceId, chvAddress1,
+    chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode FROM tb_Address WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = FALSE`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, mserviceId, partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var addrs []*pb.Address
+
+	for rows.Next() {
+		addr, err := scanAddress(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, rows.Err()
+}
+
+// get all addresses for the parties in an identifier range, ordered by party and type
+func (s *SqlStore) GetAddressesForParties(ctx context.Context, mserviceId int64, firstPartyId int64, lastPartyId int64) ([]*pb.Address, error) {
+	sqlstring := `SELECT inbPartyId, intAddressType, dtmCreated, dtmModified, intVersion, inbMserviceId, chvAddress1,
+    chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode FROM tb_Address WHERE
+    inbMserviceId = ? AND inbPartyId >= ? AND inbPartyId <= ? AND bitIsDeleted = FALSE
+    ORDER BY inbPartyId, intAddressType`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, mserviceId, firstPartyId, lastPartyId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var addrs []*pb.Address
+
+	for rows.Next() {
+		addr, err := scanAddress(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, rows.Err()
+}
+
+// create a new phone for a party, reviving a deleted phone of the same type with a fresh version
+func (s *SqlStore) CreatePhone(ctx context.Context, phone *pb.Phone) (int32, error) {
+	deleted, version, err := s.getChildSlot(ctx, "tb_Phone", "intPhoneType", phone.GetPartyId(), phone.GetPhoneType())
+
+	if err == sql.ErrNoRows {
+		version, err = s.getFirstChildVersion(ctx, "tb_Phone", "intPhoneType", phone.GetPartyId(), phone.GetPhoneType())
+		if err != nil {
+			return 0, err
+		}
+
+		sqlstring := `INSERT INTO tb_Phone (inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+    intVersion, inbMserviceId, chvPhoneNumber) VALUES (?, ?, NOW(), NOW(), NOW(), FALSE, ?, ?, ?)`
+
+		// a concurrent create may fill the slot first
+		err = s.insertUnique(ctx, sqlstring, phone.GetPartyId(), phone.GetPhoneType(), version, phone.GetMserviceId(),
+			phone.GetPhoneNumber())
+		if err != nil {
+			return 0, err
+		}
+
+		return version, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !deleted {
+		return 0, ErrConflict
+	}
+
+	sqlstring := `UPDATE tb_Phone SET dtmCreated = NOW(), dtmModified = NOW(), dtmDeleted = NOW(), bitIsDeleted = FALSE,
+    intVersion = ?, inbMserviceId = ?, chvPhoneNumber = ? WHERE
+    inbPartyId = ? AND intPhoneType = ? AND intVersion = ? AND bitIsDeleted = TRUE`
+
+	version, err = s.execVersioned(ctx, sqlstring, version, version+1, phone.GetMserviceId(), phone.GetPhoneNumber(),
+		phone.GetPartyId(), phone.GetPhoneType(), version)
+	if err == ErrNotFound {
+		// revived or changed since getChildSlot
+		return 0, ErrConflict
+	}
+
+	return version, err
+}
+
+// create new phones for parties in one statement, in empty slots; all start at version 1
+func (s *SqlStore) CreatePhones(ctx context.Context, phones []*pb.Phone) error {
+	sqlstring := `INSERT INTO tb_Phone (inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+    intVersion, inbMserviceId, chvPhoneNumber) VALUES `
+
+	var rows [][]interface{}
+	for _, phone := range phones {
+		rows = append(rows, []interface{}{phone.GetPartyId(), phone.GetPhoneType(), phone.GetMserviceId(),
+			phone.GetPhoneNumber()})
+	}
+
+	return s.insertRows(ctx, sqlstring, "(?, ?, NOW(), NOW(), NOW(), FALSE, 1, ?, ?)", rows)
+}
+
+// update an existing phone for a party
+func (s *SqlStore) UpdatePhone(ctx context.Context, phone *pb.Phone) (int32, error) {
+	sqlstring := `UPDATE tb_Phone SET dtmModified = NOW(), intVersion = ?, chvPhoneNumber = ? WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND intPhoneType = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	return s.execVersioned(ctx, sqlstring, phone.GetVersion(), phone.GetVersion()+1, phone.GetPhoneNumber(),
+		phone.GetMserviceId(), phone.GetPartyId(), phone.GetPhoneType(), phone.GetVersion())
+}
+
+// delete an existing phone for a party
+func (s *SqlStore) DeletePhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32,
+	version int32) (int32, error) {
+	sqlstring := `UPDATE tb_Phone SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND intPhoneType = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	return s.execVersioned(ctx, sqlstring, version, version+1, mserviceId, partyId, phoneType, version)
+}
+
+// delete all live phones for a party, bumping their versions
+func (s *SqlStore) DeletePhones(ctx context.Context, mserviceId int64, partyId int64) error {
+	sqlstring := `UPDATE tb_Phone SET dtmDeleted = NOW(), intVersion = intVersion + 1, bitIsDeleted = TRUE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = FALSE`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, mserviceId, partyId)
+	return err
+}
+
+// restore a deleted phone for a party
+func (s *SqlStore) RestorePhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32,
+	version int32) (int32, error) {
+	sqlstring := `UPDATE tb_Phone SET dtmModified = NOW(), intVersion = ?, bitIsDeleted = FALSE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND intPhoneType = ? AND intVersion = ? AND bitIsDeleted = TRUE`
+
+	return s.execVersioned(ctx, sqlstring, version, version+1, mserviceId, partyId, phoneType, version)
+}
+
+// restore the phones deleted with a party, at or after its deletion, bumping their versions
+func (s *SqlStore) RestorePhones(ctx context.Context, mserviceId int64, partyId int64) error {
+	sqlstring := `UPDATE tb_Phone SET dtmModified = NOW(), intVersion = intVersion + 1, bitIsDeleted = FALSE WHERE
+    inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = TRUE AND
+    dtmDeleted >= (SELECT dtmDeleted FROM tb_Party WHERE inbPartyId = ?)`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, mserviceId, partyId, partyId)
+	return err
+}
+
+// get a phone for a party by type
+func (s *SqlStore) GetPhone(ctx context.Context, mserviceId int64, partyId int64, phoneType int32) (*pb.Phone, error) {
+	sqlstring := `SELECT inbPartyId, intPhoneType, dtmCreated, dtmModified, intVersion, inbMserviceId,
+    chvPhoneNumber FROM tb_Phone WHERE inbMserviceId = ? AND inbPartyId = ? AND intPhoneType = ? AND bitIsDeleted = FALSE`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	phone, err := scanPhone(stmt.QueryRowContext(ctx, mserviceId, partyId, phoneType))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+
+	return phone, err
+}
+
+// get all phones for a party
+func (s *SqlStore) GetPhones(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.Phone, error) {
+	sqlstring := `SELECT inbPartyId, intPhoneType, dtmCreated, dtmModified, intVersion, inbMserviceId,
+    chvPhoneNumber FROM tb_Phone WHERE inbMserviceId = ? AND inbPartyId = ? AND bitIsDeleted = FALSE ORDER BY intPhoneType`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, mserviceId, partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var phones []*pb.Phone
+
+	for rows.Next() {
+		phone, err := scanPhone(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		phones = append(phones, phone)
+	}
+
+	return phones, rows.Err()
+}
+
+// get all phones for the parties in an identifier range, ordered by party and type
+func (s *SqlStore) GetPhonesForParties(ctx context.Context, mserviceId int64, firstPartyId int64, lastPartyId int64) ([]*pb.Phone, error) {
+	sqlstring := `SELECT inbPartyId, intPhoneType, dtmCreated, dtmModified, intVersion, inbMserviceId,
+    chvPhoneNumber FROM tb_Phone WHERE inbMserviceId = ? AND inbPartyId >= ? AND inbPartyId <= ? AND
+    bitIsDeleted = FALSE ORDER BY inbPartyId, intPhoneType`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, mserviceId, firstPartyId, lastPartyId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var phones []*pb.Phone
+
+	for rows.Next() {
+		phone, err := scanPhone(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		phones = append(phones, phone)
+	}
+
+	return phones, rows.Err()
+}
+
+// Helper to build a case insensitive LIKE prefix pattern, escaped with '!'.
+func likePrefix(prefix string) string {
+	prefix = strings.ToLower(prefix)
+	prefix = strings.ReplaceAll(prefix, "!", "!!")
+	prefix = strings.ReplaceAll(prefix, "%", "!%")
+	prefix = strings.ReplaceAll(prefix, "_", "!_")
+	return prefix + "%"
+}
+
+// Common interface for sql.Row and sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Columns of the party, address and phone tables copied to their history tables.
+const (
+	partyHistoryColumns = `inbPartyId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	intPartyType, chvLastName, chvMiddleName, chvFirstName, chvNickname, chvCompany, chvEmail`
+	addressHistoryColumns = `inbPartyId, intAddressType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+	inbMserviceId, chvAddress1, chvAddress2, chvCity, chvState, chvPostalCode, chvCountryCode`
+	phoneHistoryColumns = `inbPartyId, intPhoneType, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+	inbMserviceId, chvPhoneNumber`
+)
+
+// record the versions of the party and its addresses and phones, deleted or not, that are not yet in
+// the history, as changed now by changedBy in the next change sequence of the party
+func (s *SqlStore) RecordHistory(ctx context.Context, mserviceId int64, partyId int64, changedBy string) error {
+	sqlstring := `SELECT COALESCE(MAX(intSequence), 0) FROM (SELECT intSequence FROM tb_PartyHistory
+	WHERE inbPartyId = ? UNION ALL SELECT intSequence FROM tb_AddressHistory WHERE inbPartyId = ? UNION ALL
+	SELECT intSequence FROM tb_PhoneHistory WHERE inbPartyId = ?) seq`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	var sequence int32
+	err = stmt.QueryRowContext(ctx, partyId, partyId, partyId).Scan(&sequence)
+	if err != nil {
+		return err
+	}
+
+	sequence++
+
+	err = s.recordTableHistory(ctx, "tb_Party", partyHistoryColumns, "", mserviceId, partyId, changedBy, sequence)
+	if err != nil {
+		return err
+	}
+
+	err = s.recordTableHistory(ctx, "tb_Address", addressHistoryColumns, "intAddressType", mserviceId, partyId,
+		changedBy, sequence)
+	if err != nil {
+		return err
+	}
+
+	return s.recordTableHistory(ctx, "tb_Phone", phoneHistoryColumns, "intPhoneType", mserviceId, partyId, changedBy,
+		sequence)
+}
+
+// Helper to copy the rows of a table for a party whose version is not yet in its history table;
+// typeColumn is empty for tb_Party.
+func (s *SqlStore) recordTableHistory(ctx context.Context, table string, columns string, typeColumn string,
+	mserviceId int64, partyId int64, changedBy string, sequence int32) error {
+	typeMatch := ""
+	if typeColumn != "" {
+		typeMatch = " AND h." + typeColumn + " = t." + typeColumn
+	}
+
+	sqlstring := "INSERT INTO " + table + "History (" + columns + ", dtmChanged, chvChangedBy, intSequence) SELECT " +
+		columns + ", NOW(), ?, ? FROM " + table + " t WHERE t.inbMserviceId = ? AND t.inbPartyId = ? AND NOT EXISTS " +
+		"(SELECT 1 FROM " + table + "History h WHERE h.inbPartyId = t.inbPartyId" + typeMatch +
+		" AND h.intVersion = t.intVersion)"
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, changedBy, sequence, mserviceId, partyId)
+
+	return err
+}
+
+// get the recorded versions of the party and its addresses and phones, oldest first; the new_party,
+// new_address or new_phone of each entry is set, but not the old values or action
+func (s *SqlStore) GetPartyHistory(ctx context.Context, mserviceId int64, partyId int64) ([]*pb.HistoryEntry, error) {
+	var entries []*pb.HistoryEntry
+
+	err := s.queryHistory(ctx, "tb_PartyHistory", partyHistoryColumns, mserviceId, partyId, func(rows *sql.Rows) error {
+		entry, err := scanPartyHistory(rows)
+		if err == nil {
+			entries = append(entries, entry)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queryHistory(ctx, "tb_AddressHistory", addressHistoryColumns, mserviceId, partyId, func(rows *sql.Rows) error {
+		entry, err := scanAddressHistory(rows)
+		if err == nil {
+			entries = append(entries, entry)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queryHistory(ctx, "tb_PhoneHistory", phoneHistoryColumns, mserviceId, partyId, func(rows *sql.Rows) error {
+		entry, err := scanPhoneHistory(rows)
+		if err == nil {
+			entries = append(entries, entry)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortHistory(entries)
+
+	return entries, nil
+}
+
+// Helper to query the rows of a history table for a party, calling scan for each.
+func (s *SqlStore) queryHistory(ctx context.Context, table string, columns string, mserviceId int64, partyId int64,
+	scan func(rows *sql.Rows) error) error {
+	sqlstring := "SELECT " + columns + ", dtmChanged, chvChangedBy, intSequence FROM " + table +
+		" WHERE inbMserviceId = ? AND inbPartyId = ?"
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, mserviceId, partyId)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		err = scan(rows)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Helper to scan a tb_Party row into a Party.
+func scanParty(row rowScanner) (*pb.Party, error) {
+	var created string
+	var modified string
+	var party pb.Party
+
+	err := row.Scan(&party.PartyId, &created, &modified, &party.Version, &party.MserviceId, &party.PartyType,
+		&party.LastName, &party.MiddleName, &party.FirstName, &party.Nickname, &party.Company, &party.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	party.Created = dml.DateTimeFromString(created)
+	party.Modified = dml.DateTimeFromString(modified)
+
+	return &party, nil
+}
+
+// Helper to scan a tb_Party row followed by dtmDeleted into a deleted Party.
+func scanDeletedParty(row rowScanner) (*pb.Party, error) {
+	var created string
+	var modified string
+	var deleted string
+	var party pb.Party
+
+	err := row.Scan(&party.PartyId, &created, &modified, &party.Version, &party.MserviceId, &party.PartyType,
+		&party.LastName, &party.MiddleName, &party.FirstName, &party.Nickname, &party.Company, &party.Email, &deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	party.Created = dml.DateTimeFromString(created)
+	party.Modified = dml.DateTimeFromString(modified)
+	party.Deleted = dml.DateTimeFromString(deleted)
+	party.IsDeleted = true
+
+	return &party, nil
+}
+
+// Helper to scan a tb_Address row into an Address.
+func scanAddress(row rowScanner) (*pb.Address, error) {
+	var created string
+	var modified string
+	var addr pb.Address
+
+	err := row.Scan(&addr.PartyId, &addr.AddressType, &created, &modified, &addr.Version, &addr.MserviceId,
+		&addr.Address_1, &addr.Address_2, &addr.City, &addr.State, &addr.PostalCode, &addr.CountryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	addr.Created = dml.DateTimeFromString(created)
+	addr.Modified = dml.DateTimeFromString(modified)
+
+	return &addr, nil
+}
+
+// Helper to scan a tb_Phone row into a Phone.
+func scanPhone(row rowScanner) (*pb.Phone, error) {
+	var created string
+	var modified string
+	var phone pb.Phone
+
+	err := row.Scan(&phone.PartyId, &phone.PhoneType, &created, &modified, &phone.Version, &phone.MserviceId,
+		&phone.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	phone.Created = dml.DateTimeFromString(created)
+	phone.Modified = dml.DateTimeFromString(modified)
+
+	return &phone, nil
+}
+
+// Helper to scan a tb_PartyHistory row into a HistoryEntry.
+func scanPartyHistory(row rowScanner) (*pb.HistoryEntry, error) {
+	var created string
+	var modified string
+	var deleted string
+	var changed string
+	var party pb.Party
+	var entry pb.HistoryEntry
+
+	err := row.Scan(&party.PartyId, &created, &modified, &deleted, &party.IsDeleted, &party.Version,
+		&party.MserviceId, &party.PartyType, &party.LastName, &party.MiddleName, &party.FirstName, &party.Nickname,
+		&party.Company, &party.Email, &changed, &entry.ChangedBy, &entry.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	party.Created = dml.DateTimeFromString(created)
+	party.Modified = dml.DateTimeFromString(modified)
+	if party.IsDeleted {
+		party.Deleted = dml.DateTimeFromString(deleted)
+	}
+
+	entry.RecordType = HistoryParty
+	entry.PartyId = party.PartyId
+	entry.Version = party.Version
+	entry.Changed = dml.DateTimeFromString(changed)
+	entry.NewParty = &party
+
+	return &entry, nil
+}
+
+// Helper to scan a tb_AddressHistory row into a HistoryEntry.
+func scanAddressHistory(row rowScanner) (*pb.HistoryEntry, error) {
+	var created string
+	var modified string
+	var deleted string
+	var changed string
+	var addr pb.Address
+	var entry pb.HistoryEntry
+
+	err := row.Scan(&addr.PartyId, &addr.AddressType, &created, &modified, &deleted, &addr.IsDeleted, &addr.Version,
+		&addr.MserviceId, &addr.Address_1, &addr.Address_2, &addr.City, &addr.State, &addr.PostalCode,
+		&addr.CountryCode, &changed, &entry.ChangedBy, &entry.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	addr.Created = dml.DateTimeFromString(created)
+	addr.Modified = dml.DateTimeFromString(modified)
+	if addr.IsDeleted {
+		addr.Deleted = dml.DateTimeFromString(deleted)
+	}
+
+	entry.RecordType = HistoryAddress
+	entry.PartyId = addr.PartyId
+	entry.ChildType = addr.AddressType
+	entry.Version = addr.Version
+	entry.Changed = dml.DateTimeFromString(changed)
+	entry.NewAddress = &addr
+
+	return &entry, nil
+}
+
+// Helper to scan a tb_PhoneHistory row into a HistoryEntry.
+func scanPhoneHistory(row rowScanner) (*pb.HistoryEntry, error) {
+	var created string
+	var modified string
+	var deleted string
+	var changed string
+	var phone pb.Phone
+	var entry pb.HistoryEntry
+
+	err := row.Scan(&phone.PartyId, &phone.PhoneType, &created, &modified, &deleted, &phone.IsDeleted,
+		&phone.Version, &phone.MserviceId, &phone.PhoneNumber, &changed, &entry.ChangedBy, &entry.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	phone.Created = dml.DateTimeFromString(created)
+	phone.Modified = dml.DateTimeFromString(modified)
+	if phone.IsDeleted {
+		phone.Deleted = dml.DateTimeFromString(deleted)
+	}
+
+	entry.RecordType = HistoryPhone
+	entry.PartyId = phone.PartyId
+	entry.ChildType = phone.PhoneType
+	entry.Version = phone.Version
+	entry.Changed = dml.DateTimeFromString(changed)
+	entry.NewPhone = &phone
+
+	return &entry, nil
+}
+
+// write an entry to the audit log, setting its identifier and date
+func (s *SqlStore) WriteAuditEntry(ctx context.Context, entry *pb.AuditEntry) error {
+	sqlstring := `INSERT INTO tb_AuditLog (dtmLogged, chvUserId, inbMserviceId, chvClaim, chvRpc, inbPartyId,
+    intErrorCode) VALUES (NOW(), ?, ?, ?, ?, ?, ?)`
+
+	logged := time.Now()
+
+	auditId, err := s.insertReturningId(ctx, sqlstring, "inbAuditId", entry.GetUserId(), entry.GetMserviceId(),
+		entry.GetClaim(), entry.GetRpc(), entry.GetPartyId(), entry.GetErrorCode())
+	if err != nil {
+		return err
+	}
+
+	entry.AuditId = auditId
+	entry.Logged = dml.DateTimeFromTime(logged)
+
+	return nil
+}
+
+// get the audit entries of an mservice account matching the non-empty fields of the filter, most
+// recent first, returning at most filter.limit entries (0 for all)
+func (s *SqlStore) QueryAuditLog(ctx context.Context, filter *pb.QueryAuditLogRequest) ([]*pb.AuditEntry, error) {
+	var sb strings.Builder
+	var args []interface{}
+
+	sb.WriteString(`SELECT inbAuditId, dtmLogged, chvUserId, inbMserviceId, chvClaim, chvRpc, inbPartyId, intErrorCode
+    FROM tb_AuditLog WHERE inbMserviceId = ?`)
+	args = append(args, filter.GetMserviceId())
+
+	if filter.GetUserId() != "" {
+		sb.WriteString(" AND chvUserId = ?")
+		args = append(args, filter.GetUserId())
+	}
+
+	if filter.GetPartyId() != 0 {
+		sb.WriteString(" AND inbPartyId = ?")
+		args = append(args, filter.GetPartyId())
+	}
+
+	if filter.GetLoggedFrom() != nil {
+		sb.WriteString(" AND dtmLogged >= ?")
+		args = append(args, formatCursorTime(filter.GetLoggedFrom().TimeFromDateTime()))
+	}
+
+	if filter.GetLoggedTo() != nil {
+		sb.WriteString(" AND dtmLogged <= ?")
+		args = append(args, formatCursorTime(filter.GetLoggedTo().TimeFromDateTime()))
+	}
+
+	sb.WriteString(" ORDER BY inbAuditId DESC")
+
+	if filter.GetLimit() > 0 {
+		sb.WriteString(" LIMIT ?")
+		args = append(args, filter.GetLimit())
+	}
+
+	stmt, err := s.prepare(ctx, sb.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var entries []*pb.AuditEntry
+
+	for rows.Next() {
+		var logged string
+		var entry pb.AuditEntry
+
+		err = rows.Scan(&entry.AuditId, &logged, &entry.UserId, &entry.MserviceId, &entry.Claim, &entry.Rpc,
+			&entry.PartyId, &entry.ErrorCode)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.Logged = dml.DateTimeFromString(logged)
+		entries = append(entries, &entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// get the unexpired entry for an idempotency key of an mservice account, or ErrNotFound
+func (s *SqlStore) GetIdempotencyEntry(ctx context.Context, mserviceId int64, key string) (*IdempotencyEntry, error) {
+	sqlstring := `SELECT inbMserviceId, chvKey, dtmCreated, dtmExpires, chvRpc, chvRequestHash, txtResponse
+    FROM tb_IdempotencyKey WHERE inbMserviceId = ? AND chvKey = ? AND dtmExpires >= ?`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	var created string
+	var expires string
+	var entry IdempotencyEntry
+
+	err = stmt.QueryRowContext(ctx, mserviceId, key, formatCursorTime(time.Now())).Scan(&entry.MserviceId,
+		&entry.Key, &created, &expires, &entry.Rpc, &entry.RequestHash, &entry.Response)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Created = dml.DateTimeFromString(created).TimeFromDateTime()
+	entry.Expires = dml.DateTimeFromString(expires).TimeFromDateTime()
+
+	return &entry, nil
+}
+
+// save the entry for an idempotency key, setting its creation date and replacing an expired entry
+// for the key; ErrConflict is returned if the key has an unexpired entry
+func (s *SqlStore) SaveIdempotencyEntry(ctx context.Context, entry *IdempotencyEntry) error {
+	sqlstring := `DELETE FROM tb_IdempotencyKey WHERE inbMserviceId = ? AND chvKey = ? AND dtmExpires < ?`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, entry.MserviceId, entry.Key, formatCursorTime(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	sqlstring = `INSERT INTO tb_IdempotencyKey (inbMserviceId, chvKey, dtmCreated, dtmExpires, chvRpc, chvRequestHash,
+    txtResponse) VALUES (?, ?, NOW(), ?, ?, ?, ?)`
+
+	created := time.Now()
+
+	// a concurrent call with the same key may save its entry first
+	err = s.insertUnique(ctx, sqlstring, entry.MserviceId, entry.Key, formatCursorTime(entry.Expires), entry.Rpc,
+		entry.RequestHash, entry.Response)
+	if err != nil {
+		return err
+	}
+
+	entry.Created = created
+
+	return nil
+}
+
+// hard delete the idempotency entries that expired before expiredBefore, returning the number removed
+func (s *SqlStore) PurgeIdempotencyEntries(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	sqlstring := `DELETE FROM tb_IdempotencyKey WHERE dtmExpires < ?`
+
+	stmt, err := s.prepare(ctx, sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, formatCursorTime(expiredBefore))
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
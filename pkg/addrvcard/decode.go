@@ -0,0 +1,384 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrvcard
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// Returned when the input is not a sequence of BEGIN:VCARD ... END:VCARD blocks.
+var ErrMalformed = errors.New("malformed vCard data")
+
+// A content line: name, parameters and value.
+type property struct {
+	name   string
+	params map[string][]string
+	value  string
+}
+
+// Check if the property has a TYPE parameter value, case insensitive.
+func (p *property) hasType(value string) bool {
+	for _, param := range p.params["TYPE"] {
+		for _, t := range strings.Split(param, ",") {
+			if strings.EqualFold(t, value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Read all vCards, converting each to a party wrapper without identifiers.
+//
+// Addresses are typed home or shipping (parcel, postal or x-shipping), and phones home, work
+// or cell; untyped entries take the first free type. Later entries for a type already
+// taken are dropped.
+func Decode(r io.Reader) ([]*pb.PartyWrapper, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var wraps []*pb.PartyWrapper
+	var card []*property
+
+	inCard := false
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		prop := parseProperty(line)
+		if prop == nil {
+			return nil, ErrMalformed
+		}
+
+		switch {
+		case (prop.name == "BEGIN") && strings.EqualFold(prop.value, "VCARD"):
+			if inCard {
+				return nil, ErrMalformed
+			}
+			inCard = true
+			card = nil
+		case (prop.name == "END") && strings.EqualFold(prop.value, "VCARD"):
+			if !inCard {
+				return nil, ErrMalformed
+			}
+			inCard = false
+			wraps = append(wraps, convertCard(card))
+		case inCard:
+			card = append(card, prop)
+		default:
+			return nil, ErrMalformed
+		}
+	}
+
+	if inCard {
+		return nil, ErrMalformed
+	}
+
+	return wraps, nil
+}
+
+// Helper to read lines, joining folded continuation lines.
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []string
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (len(lines) > 0) && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+		} else {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
+
+// Helper to parse a content line, or return nil if it has no value.
+func parseProperty(line string) *property {
+	// the value starts at the first colon outside a quoted parameter value
+	quoted := false
+	colon := -1
+	for i := 0; i < len(line); i++ {
+		if line[i] == '"' {
+			quoted = !quoted
+		} else if (line[i] == ':') && !quoted {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 0 {
+		return nil
+	}
+
+	prop := &property{params: make(map[string][]string), value: line[colon+1:]}
+
+	parts := splitUnquoted(line[:colon], ';')
+	name := strings.ToUpper(parts[0])
+
+	// drop any group prefix, as in item1.TEL
+	if pos := strings.LastIndex(name, "."); pos >= 0 {
+		name = name[pos+1:]
+	}
+
+	prop.name = name
+
+	for _, param := range parts[1:] {
+		pos := strings.Index(param, "=")
+		if pos < 0 {
+			// vCard 2.1 style bare type, as in TEL;CELL
+			prop.params["TYPE"] = append(prop.params["TYPE"], param)
+			continue
+		}
+
+		key := strings.ToUpper(param[:pos])
+		value := strings.Trim(param[pos+1:], "\"")
+		prop.params[key] = append(prop.params[key], value)
+	}
+
+	return prop
+}
+
+// Helper to split a string on sep, except inside double quotes.
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	quoted := false
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' {
+			quoted = !quoted
+		} else if (s[i] == sep) && !quoted {
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// Helper to split a value on unescaped sep, unescaping each part.
+func splitEscaped(value string, sep byte) []string {
+	var parts []string
+	var sb strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if (c == '\\') && (i+1 < len(value)) {
+			i++
+			switch value[i] {
+			case 'n', 'N':
+				sb.WriteByte('\n')
+			default:
+				sb.WriteByte(value[i])
+			}
+		} else if c == sep {
+			parts = append(parts, sb.String())
+			sb.Reset()
+		} else {
+			sb.WriteByte(c)
+		}
+	}
+
+	return append(parts, sb.String())
+}
+
+// Helper to split a structured value into its unescaped components.
+func splitComponents(value string) []string {
+	return splitEscaped(value, ';')
+}
+
+// Helper to unescape a text value, keeping only the first item of a list.
+func firstText(value string) string {
+	return strings.TrimSpace(splitEscaped(value, ',')[0])
+}
+
+// Helper to unescape a whole text value, list separators included.
+func unescapeText(value string) string {
+	return strings.Join(splitEscaped(value, ','), ",")
+}
+
+// Helper to get a component by index, or empty if missing.
+func component(components []string, i int) string {
+	if i < len(components) {
+		return strings.TrimSpace(components[i])
+	}
+
+	return ""
+}
+
+// Helper to convert the properties of one vCard to a party wrapper.
+func convertCard(card []*property) *pb.PartyWrapper {
+	wrap := &pb.PartyWrapper{}
+
+	var fn string
+	var kind string
+	var untypedAddrs []*pb.Address
+	var untypedPhones []*pb.Phone
+
+	addrTaken := make(map[int32]bool)
+	phoneTaken := make(map[int32]bool)
+
+	for _, prop := range card {
+		switch prop.name {
+		case "FN":
+			fn = firstText(prop.value)
+		case "N":
+			components := splitComponents(prop.value)
+			wrap.LastName = component(components, 0)
+			wrap.FirstName = component(components, 1)
+			wrap.MiddleName = component(components, 2)
+		case "NICKNAME":
+			wrap.Nickname = firstText(prop.value)
+		case "ORG":
+			wrap.Company = component(splitComponents(prop.value), 0)
+		case "EMAIL":
+			if (wrap.Email == "") || prop.hasType("pref") || (len(prop.params["PREF"]) > 0) {
+				wrap.Email = firstText(prop.value)
+			}
+		case "KIND", "X-ADDRESSBOOKSERVER-KIND":
+			kind = strings.ToLower(firstText(prop.value))
+		case "ADR":
+			addr := convertAdr(prop)
+			if addr.AddressType == 0 {
+				untypedAddrs = append(untypedAddrs, addr)
+			} else if !addrTaken[addr.AddressType] {
+				addrTaken[addr.AddressType] = true
+				wrap.Addresses = append(wrap.Addresses, addr)
+			}
+		case "TEL":
+			phone := convertTel(prop)
+			if phone.PhoneType == 0 {
+				untypedPhones = append(untypedPhones, phone)
+			} else if !phoneTaken[phone.PhoneType] {
+				phoneTaken[phone.PhoneType] = true
+				wrap.Phones = append(wrap.Phones, phone)
+			}
+		}
+	}
+
+	for _, addr := range untypedAddrs {
+		for _, addrType := range []pb.AddressType{pb.AddressType_Home, pb.AddressType_Shipping} {
+			if !addrTaken[int32(addrType)] {
+				addr.AddressType = int32(addrType)
+				addrTaken[addr.AddressType] = true
+				wrap.Addresses = append(wrap.Addresses, addr)
+				break
+			}
+		}
+	}
+
+	for _, phone := range untypedPhones {
+		for _, phoneType := range []pb.PhoneType{pb.PhoneType_HomePhone, pb.PhoneType_WorkPhone, pb.PhoneType_CellPhone} {
+			if !phoneTaken[int32(phoneType)] {
+				phone.PhoneType = int32(phoneType)
+				phoneTaken[phone.PhoneType] = true
+				wrap.Phones = append(wrap.Phones, phone)
+				break
+			}
+		}
+	}
+
+	if (wrap.LastName == "") && (wrap.FirstName == "") && (fn != "") && (fn != wrap.Company) {
+		names := strings.Fields(fn)
+		wrap.FirstName = names[0]
+		if len(names) > 1 {
+			wrap.LastName = names[len(names)-1]
+		}
+	}
+
+	if (kind == "org") || ((kind == "") && (wrap.LastName == "") && (wrap.FirstName == "") && (wrap.Company != "")) {
+		wrap.PartyType = int32(pb.PartyType_Business)
+	} else {
+		wrap.PartyType = int32(pb.PartyType_Person)
+	}
+
+	return wrap
+}
+
+// Helper to convert an ADR property, with address type 0 if untyped.
+func convertAdr(prop *property) *pb.Address {
+	addr := &pb.Address{}
+
+	if prop.hasType("home") {
+		addr.AddressType = int32(pb.AddressType_Home)
+	} else if prop.hasType("parcel") || prop.hasType("postal") || prop.hasType("x-shipping") {
+		addr.AddressType = int32(pb.AddressType_Shipping)
+	}
+
+	// post office box; extended address; street address; locality; region; postal code; country
+	components := splitComponents(prop.value)
+	addr.Address_1 = component(components, 2)
+	addr.Address_2 = component(components, 1)
+	if addr.Address_1 == "" {
+		addr.Address_1 = component(components, 0)
+	}
+	addr.City = component(components, 3)
+	addr.State = component(components, 4)
+	addr.PostalCode = component(components, 5)
+
+	addr.CountryCode = component(components, 6)
+
+	return addr
+}
+
+// Helper to convert a TEL property, with phone type 0 if untyped.
+func convertTel(prop *property) *pb.Phone {
+	phone := &pb.Phone{}
+
+	if prop.hasType("cell") {
+		phone.PhoneType = int32(pb.PhoneType_CellPhone)
+	} else if prop.hasType("work") {
+		phone.PhoneType = int32(pb.PhoneType_WorkPhone)
+	} else if prop.hasType("home") {
+		phone.PhoneType = int32(pb.PhoneType_HomePhone)
+	}
+
+	value := strings.TrimSpace(prop.value)
+	if strings.HasPrefix(strings.ToLower(value), "tel:") {
+		phone.PhoneNumber = telNumber(value[len("tel:"):])
+	} else {
+		phone.PhoneNumber = unescapeText(value)
+	}
+
+	return phone
+}
+
+// Helper to get the phone number of a tel uri without its scheme, as in +44-207-555-1234;ext=56,
+// with any extension written as x56 and other uri parameters dropped.
+func telNumber(uri string) string {
+	params := strings.Split(uri, ";")
+	number := params[0]
+
+	for _, param := range params[1:] {
+		if strings.HasPrefix(strings.ToLower(param), "ext=") {
+			number += "x" + param[len("ext="):]
+		}
+	}
+
+	return number
+}
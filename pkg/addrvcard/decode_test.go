@@ -0,0 +1,142 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrvcard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  *pb.PartyWrapper
+	}{
+		{"person 4.0", []string{
+			"BEGIN:VCARD",
+			"VERSION:4.0",
+			"FN:Samwise Gamgee",
+			"N:Gamgee;Samwise;;;",
+			"NICKNAME:Sam,Samwise",
+			"EMAIL;TYPE=home:sam@gamgee.org",
+			"EMAIL;PREF=1:sam@bagend.org",
+			"ADR;TYPE=home:;;3 Bagshot Row;Hobbiton;WA;98000;US",
+			"TEL;VALUE=uri;TYPE=\"cell,voice\":tel:+44-207-555-1234;ext=56",
+			"TEL;VALUE=text;TYPE=home:543-555-1111",
+			"END:VCARD",
+		}, &pb.PartyWrapper{PartyType: int32(pb.PartyType_Person), FirstName: "Samwise", LastName: "Gamgee",
+			Nickname: "Sam", Email: "sam@bagend.org",
+			Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Home), Address_1: "3 Bagshot Row",
+				City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "US"}},
+			Phones: []*pb.Phone{
+				{PhoneType: int32(pb.PhoneType_CellPhone), PhoneNumber: "+44-207-555-1234x56"},
+				{PhoneType: int32(pb.PhoneType_HomePhone), PhoneNumber: "543-555-1111"},
+			}}},
+		{"folded 3.0 business", []string{
+			"BEGIN:VCARD",
+			"VERSION:3.0",
+			"FN:Green Dragon\\, Inn",
+			"ORG:Green Dragon\\, Inn;Taproom",
+			"item1.ADR;TYPE=PARCEL:;Rear door;1 Bywater R",
+			" oad;Bywater;WA;98001;US",
+			"END:VCARD",
+		}, &pb.PartyWrapper{PartyType: int32(pb.PartyType_Business), Company: "Green Dragon, Inn",
+			Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Shipping), Address_1: "1 Bywater Road",
+				Address_2: "Rear door", City: "Bywater", State: "WA", PostalCode: "98001", CountryCode: "US"}}}},
+		{"untyped entries take free types", []string{
+			"BEGIN:VCARD",
+			"VERSION:2.1",
+			"N:Took;Peregrin",
+			"TEL;CELL:543-555-2222",
+			"TEL:543-555-3333",
+			"TEL:543-555-4444",
+			"TEL;CELL:543-555-5555",
+			"ADR:;;1 Great Smials;Tuckborough;WA;98002;",
+			"END:VCARD",
+		}, &pb.PartyWrapper{PartyType: int32(pb.PartyType_Person), FirstName: "Peregrin", LastName: "Took",
+			Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Home), Address_1: "1 Great Smials",
+				City: "Tuckborough", State: "WA", PostalCode: "98002"}},
+			Phones: []*pb.Phone{
+				{PhoneType: int32(pb.PhoneType_CellPhone), PhoneNumber: "543-555-2222"},
+				{PhoneType: int32(pb.PhoneType_HomePhone), PhoneNumber: "543-555-3333"},
+				{PhoneType: int32(pb.PhoneType_WorkPhone), PhoneNumber: "543-555-4444"},
+			}}},
+		{"name from FN", []string{
+			"BEGIN:VCARD",
+			"VERSION:4.0",
+			"FN:Meriadoc Brandybuck",
+			"END:VCARD",
+		}, &pb.PartyWrapper{PartyType: int32(pb.PartyType_Person), FirstName: "Meriadoc", LastName: "Brandybuck"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wraps, err := Decode(strings.NewReader(strings.Join(test.lines, "\r\n") + "\r\n"))
+			if (err != nil) || (len(wraps) != 1) {
+				t.Fatalf("Decode: %v %v", wraps, err)
+			}
+
+			if !proto.Equal(wraps[0], test.want) {
+				t.Fatalf("Decode: %v\nwant: %v", wraps[0], test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"no begin", "VERSION:4.0\r\nEND:VCARD\r\n"},
+		{"no end", "BEGIN:VCARD\r\nVERSION:4.0\r\n"},
+		{"nested", "BEGIN:VCARD\r\nBEGIN:VCARD\r\nEND:VCARD\r\nEND:VCARD\r\n"},
+		{"no value", "BEGIN:VCARD\r\nVERSION\r\nEND:VCARD\r\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Decode(strings.NewReader(test.data)); err != ErrMalformed {
+				t.Fatalf("Decode: %v", err)
+			}
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, version := range []string{Version30, Version40} {
+		var buf bytes.Buffer
+		Encode(&buf, frodo, version)
+		Encode(&buf, shire, version)
+
+		wraps, err := Decode(&buf)
+		if (err != nil) || (len(wraps) != 2) {
+			t.Fatalf("Decode %s: %v %v", version, wraps, err)
+		}
+
+		if got := wraps[0].GetPhones()[1].GetPhoneNumber(); got != "+44-207-555-1234x56" {
+			t.Fatalf("Decode %s work phone: %s", version, got)
+		}
+
+		if got := wraps[1].GetCompany(); (got != shire.GetCompany()) || (wraps[1].GetPartyType() != shire.GetPartyType()) {
+			t.Fatalf("Decode %s business: %v", version, wraps[1])
+		}
+	}
+}
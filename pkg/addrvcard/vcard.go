@@ -0,0 +1,243 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The addrvcard package converts MServiceAddrbook party wrappers to and from vCards,
+// version 3.0 (RFC 2426) or 4.0 (RFC 6350).
+
+package addrvcard
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+// MIME type of encoded vCards.
+const ContentType = "text/vcard"
+
+// Supported vCard versions.
+const (
+	Version30 = "3.0"
+	Version40 = "4.0"
+)
+
+// Longest content line before folding, in octets, not counting CRLF.
+const maxLineLength = 75
+
+// Phone number with a country code, in the format accepted by the service.
+var globalPhone = regexp.MustCompile("^\\+[1-9][0-9]{0,3}-[1-9][0-9]{2}-[1-9][0-9]{2}-[0-9]{4}(x[0-9]+)?$")
+
+// Check if the vCard version is supported.
+func IsValidVersion(version string) bool {
+	return (version == Version30) || (version == Version40)
+}
+
+// Get the TYPE parameter value for an address type.
+func addressTypeParam(addressType int32, version string) string {
+	var param string
+	switch addressType {
+	case int32(pb.AddressType_Home):
+		param = "home"
+	case int32(pb.AddressType_Shipping):
+		// parcel is not registered for vCard 4.0
+		if version == Version30 {
+			param = "parcel"
+		} else {
+			param = "x-shipping"
+		}
+	}
+
+	return typeCase(param, version)
+}
+
+// Get the TYPE parameter value for a phone type.
+func phoneTypeParam(phoneType int32, version string) string {
+	var param string
+	switch phoneType {
+	case int32(pb.PhoneType_HomePhone):
+		param = "home,voice"
+	case int32(pb.PhoneType_WorkPhone):
+		param = "work,voice"
+	case int32(pb.PhoneType_CellPhone):
+		param = "cell,voice"
+	}
+
+	return typeCase(param, version)
+}
+
+// Helper to use the customary case of TYPE values, upper for 3.0 and lower for 4.0.
+func typeCase(param string, version string) string {
+	if version == Version30 {
+		return strings.ToUpper(param)
+	}
+
+	return param
+}
+
+// Write a party wrapper as a single vCard.
+func Encode(w io.Writer, wrap *pb.PartyWrapper, version string) error {
+	bw := bufio.NewWriter(w)
+
+	writeLine(bw, "BEGIN:VCARD")
+	writeLine(bw, "VERSION:"+version)
+
+	isBusiness := wrap.GetPartyType() == int32(pb.PartyType_Business)
+
+	if version == Version40 {
+		if isBusiness {
+			writeLine(bw, "KIND:org")
+		} else {
+			writeLine(bw, "KIND:individual")
+		}
+	}
+
+	fn := joinNonEmpty(" ", wrap.GetFirstName(), wrap.GetMiddleName(), wrap.GetLastName())
+	if isBusiness && (wrap.GetCompany() != "") {
+		fn = wrap.GetCompany()
+	}
+
+	writeLine(bw, "FN:"+escapeText(fn))
+	writeLine(bw, "N:"+escapeText(wrap.GetLastName())+";"+escapeText(wrap.GetFirstName())+";"+
+		escapeText(wrap.GetMiddleName())+";;")
+
+	if wrap.GetNickname() != "" {
+		writeLine(bw, "NICKNAME:"+escapeText(wrap.GetNickname()))
+	}
+
+	if wrap.GetCompany() != "" {
+		writeLine(bw, "ORG:"+escapeText(wrap.GetCompany()))
+	}
+
+	if wrap.GetEmail() != "" {
+		if version == Version30 {
+			writeLine(bw, "EMAIL;TYPE=INTERNET:"+escapeText(wrap.GetEmail()))
+		} else {
+			writeLine(bw, "EMAIL:"+escapeText(wrap.GetEmail()))
+		}
+	}
+
+	for _, addr := range wrap.GetAddresses() {
+		name := "ADR"
+		if param := addressTypeParam(addr.GetAddressType(), version); param != "" {
+			name += ";TYPE=" + param
+		}
+
+		// post office box; extended address; street address; locality; region; postal code; country
+		writeLine(bw, name+":;"+escapeText(addr.GetAddress_2())+";"+escapeText(addr.GetAddress_1())+";"+
+			escapeText(addr.GetCity())+";"+escapeText(addr.GetState())+";"+escapeText(addr.GetPostalCode())+";"+
+			escapeText(strings.ToUpper(addr.GetCountryCode())))
+	}
+
+	for _, phone := range wrap.GetPhones() {
+		name := "TEL"
+		value := escapeText(phone.GetPhoneNumber())
+
+		// a 4.0 TEL is a uri unless marked as text
+		if version == Version40 {
+			if uri := telUri(phone.GetPhoneNumber()); uri != "" {
+				name += ";VALUE=uri"
+				value = uri
+			} else {
+				name += ";VALUE=text"
+			}
+		}
+
+		if param := phoneTypeParam(phone.GetPhoneType(), version); param != "" {
+			if strings.Contains(param, ",") && (version == Version40) {
+				param = "\"" + param + "\""
+			}
+			name += ";TYPE=" + param
+		}
+
+		writeLine(bw, name+":"+value)
+	}
+
+	if wrap.GetModified() != nil {
+		rev := wrap.GetModified().TimeFromDateTime().UTC()
+		if version == Version30 {
+			writeLine(bw, "REV:"+rev.Format(time.RFC3339))
+		} else {
+			writeLine(bw, "REV:"+rev.Format("20060102T150405Z"))
+		}
+	}
+
+	writeLine(bw, "END:VCARD")
+
+	return bw.Flush()
+}
+
+// Helper to get the tel uri (RFC 3966) of a phone number with a country code, as in
+// +44-207-555-1234x56, or empty for a number without one, which has no global form.
+func telUri(number string) string {
+	if !globalPhone.MatchString(number) {
+		return ""
+	}
+
+	if pos := strings.IndexByte(number, 'x'); pos >= 0 {
+		return "tel:" + number[:pos] + ";ext=" + number[pos+1:]
+	}
+
+	return "tel:" + number
+}
+
+// Helper to join the non-empty strings with a separator.
+func joinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+
+	return strings.Join(parts, sep)
+}
+
+// Helper to escape a text value: backslash, comma, semicolon and newline.
+func escapeText(value string) string {
+	value = strings.ReplaceAll(value, "\\", "\\\\")
+	value = strings.ReplaceAll(value, ",", "\\,")
+	value = strings.ReplaceAll(value, ";", "\\;")
+	value = strings.ReplaceAll(value, "\r\n", "\\n")
+	value = strings.ReplaceAll(value, "\n", "\\n")
+	return value
+}
+
+// Helper to write a content line, folded at maxLineLength octets without splitting UTF-8 sequences.
+func writeLine(bw *bufio.Writer, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for (cut > 0) && !isRuneStart(line[cut]) {
+			cut--
+		}
+
+		bw.WriteString(line[:cut])
+		bw.WriteString("\r\n ")
+		line = line[cut:]
+
+		// continuation lines start with a space
+		limit = maxLineLength - 1
+	}
+
+	bw.WriteString(line)
+	bw.WriteString("\r\n")
+}
+
+// Helper to check if a byte starts a UTF-8 sequence.
+func isRuneStart(b byte) bool {
+	return (b & 0xC0) != 0x80
+}
@@ -0,0 +1,165 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrvcard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	pb "github.com/gaterace/addrbook/pkg/mserviceaddrbook"
+)
+
+var frodo = &pb.PartyWrapper{
+	PartyType: int32(pb.PartyType_Person),
+	FirstName: "Frodo",
+	LastName:  "Baggins",
+	Nickname:  "Mr. Underhill",
+	Email:     "frodo@baggins.org",
+	Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Home), Address_1: "1 Bag End", City: "Hobbiton",
+		State: "WA", PostalCode: "98000", CountryCode: "us"}},
+	Phones: []*pb.Phone{
+		{PhoneType: int32(pb.PhoneType_CellPhone), PhoneNumber: "543-555-1111"},
+		{PhoneType: int32(pb.PhoneType_WorkPhone), PhoneNumber: "+44-207-555-1234x56"},
+	},
+}
+
+var shire = &pb.PartyWrapper{
+	PartyType: int32(pb.PartyType_Business),
+	Company:   "Shire Post; Hobbiton, Bywater",
+	Addresses: []*pb.Address{{AddressType: int32(pb.AddressType_Shipping), Address_1: "2 Bagshot Row",
+		City: "Hobbiton", State: "WA", PostalCode: "98000", CountryCode: "us"}},
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		wrap    *pb.PartyWrapper
+		version string
+		want    []string
+	}{
+		{"person 4.0", frodo, Version40, []string{
+			"BEGIN:VCARD",
+			"VERSION:4.0",
+			"KIND:individual",
+			"FN:Frodo Baggins",
+			"N:Baggins;Frodo;;;",
+			"NICKNAME:Mr. Underhill",
+			"EMAIL:frodo@baggins.org",
+			"ADR;TYPE=home:;;1 Bag End;Hobbiton;WA;98000;US",
+			"TEL;VALUE=text;TYPE=\"cell,voice\":543-555-1111",
+			"TEL;VALUE=uri;TYPE=\"work,voice\":tel:+44-207-555-1234;ext=56",
+			"END:VCARD",
+		}},
+		{"person 3.0", frodo, Version30, []string{
+			"BEGIN:VCARD",
+			"VERSION:3.0",
+			"FN:Frodo Baggins",
+			"N:Baggins;Frodo;;;",
+			"NICKNAME:Mr. Underhill",
+			"EMAIL;TYPE=INTERNET:frodo@baggins.org",
+			"ADR;TYPE=HOME:;;1 Bag End;Hobbiton;WA;98000;US",
+			"TEL;TYPE=CELL,VOICE:543-555-1111",
+			"TEL;TYPE=WORK,VOICE:+44-207-555-1234x56",
+			"END:VCARD",
+		}},
+		{"business 4.0", shire, Version40, []string{
+			"BEGIN:VCARD",
+			"VERSION:4.0",
+			"KIND:org",
+			"FN:Shire Post\\; Hobbiton\\, Bywater",
+			"N:;;;;",
+			"ORG:Shire Post\\; Hobbiton\\, Bywater",
+			"ADR;TYPE=x-shipping:;;2 Bagshot Row;Hobbiton;WA;98000;US",
+			"END:VCARD",
+		}},
+		{"business 3.0", shire, Version30, []string{
+			"BEGIN:VCARD",
+			"VERSION:3.0",
+			"FN:Shire Post\\; Hobbiton\\, Bywater",
+			"N:;;;;",
+			"ORG:Shire Post\\; Hobbiton\\, Bywater",
+			"ADR;TYPE=PARCEL:;;2 Bagshot Row;Hobbiton;WA;98000;US",
+			"END:VCARD",
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Encode(&buf, test.wrap, test.version); err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+
+			want := strings.Join(test.want, "\r\n") + "\r\n"
+			if buf.String() != want {
+				t.Fatalf("Encode:\n%s\nwant:\n%s", buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestTelUri(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{"+1-543-555-1111", "tel:+1-543-555-1111"},
+		{"+44-207-555-1234x56", "tel:+44-207-555-1234;ext=56"},
+		{"543-555-1111", ""},
+		{"543-555-1111x12", ""},
+		{"call the post office", ""},
+	}
+
+	for _, test := range tests {
+		if got := telUri(test.number); got != test.want {
+			t.Errorf("telUri(%q) = %q, want %q", test.number, got, test.want)
+		}
+	}
+}
+
+func TestWriteLineFolds(t *testing.T) {
+	tests := []struct {
+		name     string
+		nickname string
+	}{
+		{"ascii", strings.Repeat("a", 200)},
+		{"utf-8", strings.Repeat("é", 100)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			Encode(&buf, &pb.PartyWrapper{Nickname: test.nickname}, Version40)
+
+			var unfolded strings.Builder
+			for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+				if (len(line) > maxLineLength) || !utf8.ValidString(line) {
+					t.Fatalf("folded line of %d octets: %q", len(line), line)
+				}
+
+				if strings.HasPrefix(line, " ") {
+					unfolded.WriteString(line[1:])
+				} else {
+					unfolded.WriteString("\n" + line)
+				}
+			}
+
+			if !strings.Contains(unfolded.String(), "\nNICKNAME:"+test.nickname+"\n") {
+				t.Fatalf("unfolded vCard lost the nickname: %q", unfolded.String())
+			}
+		})
+	}
+}
@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: MServiceAddrbook.proto
 
 package mserviceaddrbook
 
@@ -28,7 +32,7 @@ type MServiceAddrbookClient interface {
 	GetParty(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*GetPartyResponse, error)
 	// get parties by mservice id
 	GetParties(ctx context.Context, in *GetPartiesRequest, opts ...grpc.CallOption) (*GetPartiesResponse, error)
-	// get party wrapper by id
+	// get party wrapper by id, or as it was at an earlier time
 	GetPartyWrapper(ctx context.Context, in *GetPartyWrapperRequest, opts ...grpc.CallOption) (*GetPartyWrapperResponse, error)
 	// create a new address for a party
 	CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error)
@@ -48,6 +52,42 @@ type MServiceAddrbookClient interface {
 	GetPhone(ctx context.Context, in *GetPhoneRequest, opts ...grpc.CallOption) (*GetPhoneResponse, error)
 	// get current server version and uptime - health check
 	GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error)
+	// search parties by name, company, email, party type, phone or address
+	SearchParties(ctx context.Context, in *SearchPartiesRequest, opts ...grpc.CallOption) (*SearchPartiesResponse, error)
+	// stream all party wrappers for the mservice account, one per message
+	StreamPartyWrappers(ctx context.Context, in *StreamPartyWrappersRequest, opts ...grpc.CallOption) (MServiceAddrbook_StreamPartyWrappersClient, error)
+	// export a party, or all parties, as vCards
+	ExportVcard(ctx context.Context, in *ExportVcardRequest, opts ...grpc.CallOption) (*ExportVcardResponse, error)
+	// import parties with their addresses and phones from vCards
+	ImportVcard(ctx context.Context, in *ImportVcardRequest, opts ...grpc.CallOption) (*ImportVcardResponse, error)
+	// export all parties as CSV, one row per party with its addresses and phones
+	ExportCsv(ctx context.Context, in *ExportCsvRequest, opts ...grpc.CallOption) (*ExportCsvResponse, error)
+	// import parties with their addresses and phones from CSV
+	ImportCsv(ctx context.Context, in *ImportCsvRequest, opts ...grpc.CallOption) (*ImportCsvResponse, error)
+	// create or update parties with their addresses and phones, one transaction per party
+	BulkUpsertPartyWrappers(ctx context.Context, opts ...grpc.CallOption) (MServiceAddrbook_BulkUpsertPartyWrappersClient, error)
+	// create new party with its addresses and phones in one transaction
+	CreatePartyWrapper(ctx context.Context, in *CreatePartyWrapperRequest, opts ...grpc.CallOption) (*CreatePartyWrapperResponse, error)
+	// get deleted parties by mservice id, most recently deleted first
+	GetDeletedParties(ctx context.Context, in *GetDeletedPartiesRequest, opts ...grpc.CallOption) (*GetDeletedPartiesResponse, error)
+	// restore a deleted party, optionally with the addresses and phones deleted with it
+	RestoreParty(ctx context.Context, in *RestorePartyRequest, opts ...grpc.CallOption) (*RestorePartyResponse, error)
+	// restore a deleted address for a party
+	RestoreAddress(ctx context.Context, in *RestoreAddressRequest, opts ...grpc.CallOption) (*RestoreAddressResponse, error)
+	// restore a deleted phone for a party
+	RestorePhone(ctx context.Context, in *RestorePhoneRequest, opts ...grpc.CallOption) (*RestorePhoneResponse, error)
+	// permanently remove parties, addresses and phones deleted longer ago than the retention window
+	PurgeDeleted(ctx context.Context, in *PurgeDeletedRequest, opts ...grpc.CallOption) (*PurgeDeletedResponse, error)
+	// get the recorded versions of a party and its addresses and phones, oldest first
+	GetPartyHistory(ctx context.Context, in *GetPartyHistoryRequest, opts ...grpc.CallOption) (*GetPartyHistoryResponse, error)
+	// get the audit trail of calls to the service, most recent first
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// update the fields of an existing party listed in the update mask
+	PatchParty(ctx context.Context, in *PatchPartyRequest, opts ...grpc.CallOption) (*PatchPartyResponse, error)
+	// update the fields of an existing address listed in the update mask
+	PatchAddress(ctx context.Context, in *PatchAddressRequest, opts ...grpc.CallOption) (*PatchAddressResponse, error)
+	// update the fields of an existing phone listed in the update mask
+	PatchPhone(ctx context.Context, in *PatchPhoneRequest, opts ...grpc.CallOption) (*PatchPhoneResponse, error)
 }
 
 type mServiceAddrbookClient struct {
@@ -193,6 +233,216 @@ func (c *mServiceAddrbookClient) GetServerVersion(ctx context.Context, in *GetSe
 	return out, nil
 }
 
+func (c *mServiceAddrbookClient) SearchParties(ctx context.Context, in *SearchPartiesRequest, opts ...grpc.CallOption) (*SearchPartiesResponse, error) {
+	out := new(SearchPartiesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/search_parties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) StreamPartyWrappers(ctx context.Context, in *StreamPartyWrappersRequest, opts ...grpc.CallOption) (MServiceAddrbook_StreamPartyWrappersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MServiceAddrbook_ServiceDesc.Streams[0], "/org.gaterace.mservice.addrbook.MServiceAddrbook/stream_party_wrappers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mServiceAddrbookStreamPartyWrappersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MServiceAddrbook_StreamPartyWrappersClient interface {
+	Recv() (*StreamPartyWrappersResponse, error)
+	grpc.ClientStream
+}
+
+type mServiceAddrbookStreamPartyWrappersClient struct {
+	grpc.ClientStream
+}
+
+func (x *mServiceAddrbookStreamPartyWrappersClient) Recv() (*StreamPartyWrappersResponse, error) {
+	m := new(StreamPartyWrappersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mServiceAddrbookClient) ExportVcard(ctx context.Context, in *ExportVcardRequest, opts ...grpc.CallOption) (*ExportVcardResponse, error) {
+	out := new(ExportVcardResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/export_vcard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) ImportVcard(ctx context.Context, in *ImportVcardRequest, opts ...grpc.CallOption) (*ImportVcardResponse, error) {
+	out := new(ImportVcardResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/import_vcard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) ExportCsv(ctx context.Context, in *ExportCsvRequest, opts ...grpc.CallOption) (*ExportCsvResponse, error) {
+	out := new(ExportCsvResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/export_csv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) ImportCsv(ctx context.Context, in *ImportCsvRequest, opts ...grpc.CallOption) (*ImportCsvResponse, error) {
+	out := new(ImportCsvResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/import_csv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) BulkUpsertPartyWrappers(ctx context.Context, opts ...grpc.CallOption) (MServiceAddrbook_BulkUpsertPartyWrappersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MServiceAddrbook_ServiceDesc.Streams[1], "/org.gaterace.mservice.addrbook.MServiceAddrbook/bulk_upsert_party_wrappers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mServiceAddrbookBulkUpsertPartyWrappersClient{stream}
+	return x, nil
+}
+
+type MServiceAddrbook_BulkUpsertPartyWrappersClient interface {
+	Send(*PartyWrapper) error
+	CloseAndRecv() (*BulkUpsertPartyWrappersResponse, error)
+	grpc.ClientStream
+}
+
+type mServiceAddrbookBulkUpsertPartyWrappersClient struct {
+	grpc.ClientStream
+}
+
+func (x *mServiceAddrbookBulkUpsertPartyWrappersClient) Send(m *PartyWrapper) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mServiceAddrbookBulkUpsertPartyWrappersClient) CloseAndRecv() (*BulkUpsertPartyWrappersResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BulkUpsertPartyWrappersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mServiceAddrbookClient) CreatePartyWrapper(ctx context.Context, in *CreatePartyWrapperRequest, opts ...grpc.CallOption) (*CreatePartyWrapperResponse, error) {
+	out := new(CreatePartyWrapperResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/create_party_wrapper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) GetDeletedParties(ctx context.Context, in *GetDeletedPartiesRequest, opts ...grpc.CallOption) (*GetDeletedPartiesResponse, error) {
+	out := new(GetDeletedPartiesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/get_deleted_parties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) RestoreParty(ctx context.Context, in *RestorePartyRequest, opts ...grpc.CallOption) (*RestorePartyResponse, error) {
+	out := new(RestorePartyResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/restore_party", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) RestoreAddress(ctx context.Context, in *RestoreAddressRequest, opts ...grpc.CallOption) (*RestoreAddressResponse, error) {
+	out := new(RestoreAddressResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/restore_address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) RestorePhone(ctx context.Context, in *RestorePhoneRequest, opts ...grpc.CallOption) (*RestorePhoneResponse, error) {
+	out := new(RestorePhoneResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/restore_phone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) PurgeDeleted(ctx context.Context, in *PurgeDeletedRequest, opts ...grpc.CallOption) (*PurgeDeletedResponse, error) {
+	out := new(PurgeDeletedResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/purge_deleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) GetPartyHistory(ctx context.Context, in *GetPartyHistoryRequest, opts ...grpc.CallOption) (*GetPartyHistoryResponse, error) {
+	out := new(GetPartyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/get_party_history", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/query_audit_log", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) PatchParty(ctx context.Context, in *PatchPartyRequest, opts ...grpc.CallOption) (*PatchPartyResponse, error) {
+	out := new(PatchPartyResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/patch_party", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) PatchAddress(ctx context.Context, in *PatchAddressRequest, opts ...grpc.CallOption) (*PatchAddressResponse, error) {
+	out := new(PatchAddressResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/patch_address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceAddrbookClient) PatchPhone(ctx context.Context, in *PatchPhoneRequest, opts ...grpc.CallOption) (*PatchPhoneResponse, error) {
+	out := new(PatchPhoneResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.addrbook.MServiceAddrbook/patch_phone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MServiceAddrbookServer is the server API for MServiceAddrbook service.
 // All implementations must embed UnimplementedMServiceAddrbookServer
 // for forward compatibility
@@ -207,7 +457,7 @@ type MServiceAddrbookServer interface {
 	GetParty(context.Context, *GetPartyRequest) (*GetPartyResponse, error)
 	// get parties by mservice id
 	GetParties(context.Context, *GetPartiesRequest) (*GetPartiesResponse, error)
-	// get party wrapper by id
+	// get party wrapper by id, or as it was at an earlier time
 	GetPartyWrapper(context.Context, *GetPartyWrapperRequest) (*GetPartyWrapperResponse, error)
 	// create a new address for a party
 	CreateAddress(context.Context, *CreateAddressRequest) (*CreateAddressResponse, error)
@@ -227,6 +477,42 @@ type MServiceAddrbookServer interface {
 	GetPhone(context.Context, *GetPhoneRequest) (*GetPhoneResponse, error)
 	// get current server version and uptime - health check
 	GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error)
+	// search parties by name, company, email, party type, phone or address
+	SearchParties(context.Context, *SearchPartiesRequest) (*SearchPartiesResponse, error)
+	// stream all party wrappers for the mservice account, one per message
+	StreamPartyWrappers(*StreamPartyWrappersRequest, MServiceAddrbook_StreamPartyWrappersServer) error
+	// export a party, or all parties, as vCards
+	ExportVcard(context.Context, *ExportVcardRequest) (*ExportVcardResponse, error)
+	// import parties with their addresses and phones from vCards
+	ImportVcard(context.Context, *ImportVcardRequest) (*ImportVcardResponse, error)
+	// export all parties as CSV, one row per party with its addresses and phones
+	ExportCsv(context.Context, *ExportCsvRequest) (*ExportCsvResponse, error)
+	// import parties with their addresses and phones from CSV
+	ImportCsv(context.Context, *ImportCsvRequest) (*ImportCsvResponse, error)
+	// create or update parties with their addresses and phones, one transaction per party
+	BulkUpsertPartyWrappers(MServiceAddrbook_BulkUpsertPartyWrappersServer) error
+	// create new party with its addresses and phones in one transaction
+	CreatePartyWrapper(context.Context, *CreatePartyWrapperRequest) (*CreatePartyWrapperResponse, error)
+	// get deleted parties by mservice id, most recently deleted first
+	GetDeletedParties(context.Context, *GetDeletedPartiesRequest) (*GetDeletedPartiesResponse, error)
+	// restore a deleted party, optionally with the addresses and phones deleted with it
+	RestoreParty(context.Context, *RestorePartyRequest) (*RestorePartyResponse, error)
+	// restore a deleted address for a party
+	RestoreAddress(context.Context, *RestoreAddressRequest) (*RestoreAddressResponse, error)
+	// restore a deleted phone for a party
+	RestorePhone(context.Context, *RestorePhoneRequest) (*RestorePhoneResponse, error)
+	// permanently remove parties, addresses and phones deleted longer ago than the retention window
+	PurgeDeleted(context.Context, *PurgeDeletedRequest) (*PurgeDeletedResponse, error)
+	// get the recorded versions of a party and its addresses and phones, oldest first
+	GetPartyHistory(context.Context, *GetPartyHistoryRequest) (*GetPartyHistoryResponse, error)
+	// get the audit trail of calls to the service, most recent first
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// update the fields of an existing party listed in the update mask
+	PatchParty(context.Context, *PatchPartyRequest) (*PatchPartyResponse, error)
+	// update the fields of an existing address listed in the update mask
+	PatchAddress(context.Context, *PatchAddressRequest) (*PatchAddressResponse, error)
+	// update the fields of an existing phone listed in the update mask
+	PatchPhone(context.Context, *PatchPhoneRequest) (*PatchPhoneResponse, error)
 	mustEmbedUnimplementedMServiceAddrbookServer()
 }
 
@@ -279,6 +565,60 @@ func (UnimplementedMServiceAddrbookServer) GetPhone(context.Context, *GetPhoneRe
 func (UnimplementedMServiceAddrbookServer) GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServerVersion not implemented")
 }
+func (UnimplementedMServiceAddrbookServer) SearchParties(context.Context, *SearchPartiesRequest) (*SearchPartiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchParties not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) StreamPartyWrappers(*StreamPartyWrappersRequest, MServiceAddrbook_StreamPartyWrappersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPartyWrappers not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) ExportVcard(context.Context, *ExportVcardRequest) (*ExportVcardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportVcard not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) ImportVcard(context.Context, *ImportVcardRequest) (*ImportVcardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportVcard not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) ExportCsv(context.Context, *ExportCsvRequest) (*ExportCsvResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCsv not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) ImportCsv(context.Context, *ImportCsvRequest) (*ImportCsvResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCsv not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) BulkUpsertPartyWrappers(MServiceAddrbook_BulkUpsertPartyWrappersServer) error {
+	return status.Errorf(codes.Unimplemented, "method BulkUpsertPartyWrappers not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) CreatePartyWrapper(context.Context, *CreatePartyWrapperRequest) (*CreatePartyWrapperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePartyWrapper not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) GetDeletedParties(context.Context, *GetDeletedPartiesRequest) (*GetDeletedPartiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedParties not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) RestoreParty(context.Context, *RestorePartyRequest) (*RestorePartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreParty not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) RestoreAddress(context.Context, *RestoreAddressRequest) (*RestoreAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAddress not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) RestorePhone(context.Context, *RestorePhoneRequest) (*RestorePhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePhone not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) PurgeDeleted(context.Context, *PurgeDeletedRequest) (*PurgeDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeleted not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) GetPartyHistory(context.Context, *GetPartyHistoryRequest) (*GetPartyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartyHistory not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) PatchParty(context.Context, *PatchPartyRequest) (*PatchPartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchParty not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) PatchAddress(context.Context, *PatchAddressRequest) (*PatchAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchAddress not implemented")
+}
+func (UnimplementedMServiceAddrbookServer) PatchPhone(context.Context, *PatchPhoneRequest) (*PatchPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchPhone not implemented")
+}
 func (UnimplementedMServiceAddrbookServer) mustEmbedUnimplementedMServiceAddrbookServer() {}
 
 // UnsafeMServiceAddrbookServer may be embedded to opt out of forward compatibility for this service.
@@ -562,6 +902,341 @@ func _MServiceAddrbook_GetServerVersion_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceAddrbook_SearchParties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).SearchParties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/search_parties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).SearchParties(ctx, req.(*SearchPartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_StreamPartyWrappers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPartyWrappersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MServiceAddrbookServer).StreamPartyWrappers(m, &mServiceAddrbookStreamPartyWrappersServer{stream})
+}
+
+type MServiceAddrbook_StreamPartyWrappersServer interface {
+	Send(*StreamPartyWrappersResponse) error
+	grpc.ServerStream
+}
+
+type mServiceAddrbookStreamPartyWrappersServer struct {
+	grpc.ServerStream
+}
+
+func (x *mServiceAddrbookStreamPartyWrappersServer) Send(m *StreamPartyWrappersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MServiceAddrbook_ExportVcard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportVcardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).ExportVcard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/export_vcard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).ExportVcard(ctx, req.(*ExportVcardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_ImportVcard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportVcardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).ImportVcard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/import_vcard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).ImportVcard(ctx, req.(*ImportVcardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_ExportCsv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCsvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).ExportCsv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/export_csv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).ExportCsv(ctx, req.(*ExportCsvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_ImportCsv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCsvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).ImportCsv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/import_csv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).ImportCsv(ctx, req.(*ImportCsvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_BulkUpsertPartyWrappers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MServiceAddrbookServer).BulkUpsertPartyWrappers(&mServiceAddrbookBulkUpsertPartyWrappersServer{stream})
+}
+
+type MServiceAddrbook_BulkUpsertPartyWrappersServer interface {
+	SendAndClose(*BulkUpsertPartyWrappersResponse) error
+	Recv() (*PartyWrapper, error)
+	grpc.ServerStream
+}
+
+type mServiceAddrbookBulkUpsertPartyWrappersServer struct {
+	grpc.ServerStream
+}
+
+func (x *mServiceAddrbookBulkUpsertPartyWrappersServer) SendAndClose(m *BulkUpsertPartyWrappersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *mServiceAddrbookBulkUpsertPartyWrappersServer) Recv() (*PartyWrapper, error) {
+	m := new(PartyWrapper)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MServiceAddrbook_CreatePartyWrapper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartyWrapperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).CreatePartyWrapper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/create_party_wrapper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).CreatePartyWrapper(ctx, req.(*CreatePartyWrapperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_GetDeletedParties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedPartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).GetDeletedParties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/get_deleted_parties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).GetDeletedParties(ctx, req.(*GetDeletedPartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_RestoreParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).RestoreParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/restore_party",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).RestoreParty(ctx, req.(*RestorePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_RestoreAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).RestoreAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/restore_address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).RestoreAddress(ctx, req.(*RestoreAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_RestorePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).RestorePhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/restore_phone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).RestorePhone(ctx, req.(*RestorePhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_PurgeDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).PurgeDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/purge_deleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).PurgeDeleted(ctx, req.(*PurgeDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_GetPartyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).GetPartyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/get_party_history",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).GetPartyHistory(ctx, req.(*GetPartyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/query_audit_log",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_PatchParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).PatchParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/patch_party",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).PatchParty(ctx, req.(*PatchPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_PatchAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).PatchAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/patch_address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).PatchAddress(ctx, req.(*PatchAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceAddrbook_PatchPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceAddrbookServer).PatchPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.addrbook.MServiceAddrbook/patch_phone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceAddrbookServer).PatchPhone(ctx, req.(*PatchPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MServiceAddrbook_ServiceDesc is the grpc.ServiceDesc for MServiceAddrbook service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -629,7 +1304,82 @@ var MServiceAddrbook_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_server_version",
 			Handler:    _MServiceAddrbook_GetServerVersion_Handler,
 		},
+		{
+			MethodName: "search_parties",
+			Handler:    _MServiceAddrbook_SearchParties_Handler,
+		},
+		{
+			MethodName: "export_vcard",
+			Handler:    _MServiceAddrbook_ExportVcard_Handler,
+		},
+		{
+			MethodName: "import_vcard",
+			Handler:    _MServiceAddrbook_ImportVcard_Handler,
+		},
+		{
+			MethodName: "export_csv",
+			Handler:    _MServiceAddrbook_ExportCsv_Handler,
+		},
+		{
+			MethodName: "import_csv",
+			Handler:    _MServiceAddrbook_ImportCsv_Handler,
+		},
+		{
+			MethodName: "create_party_wrapper",
+			Handler:    _MServiceAddrbook_CreatePartyWrapper_Handler,
+		},
+		{
+			MethodName: "get_deleted_parties",
+			Handler:    _MServiceAddrbook_GetDeletedParties_Handler,
+		},
+		{
+			MethodName: "restore_party",
+			Handler:    _MServiceAddrbook_RestoreParty_Handler,
+		},
+		{
+			MethodName: "restore_address",
+			Handler:    _MServiceAddrbook_RestoreAddress_Handler,
+		},
+		{
+			MethodName: "restore_phone",
+			Handler:    _MServiceAddrbook_RestorePhone_Handler,
+		},
+		{
+			MethodName: "purge_deleted",
+			Handler:    _MServiceAddrbook_PurgeDeleted_Handler,
+		},
+		{
+			MethodName: "get_party_history",
+			Handler:    _MServiceAddrbook_GetPartyHistory_Handler,
+		},
+		{
+			MethodName: "query_audit_log",
+			Handler:    _MServiceAddrbook_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "patch_party",
+			Handler:    _MServiceAddrbook_PatchParty_Handler,
+		},
+		{
+			MethodName: "patch_address",
+			Handler:    _MServiceAddrbook_PatchAddress_Handler,
+		},
+		{
+			MethodName: "patch_phone",
+			Handler:    _MServiceAddrbook_PatchPhone_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "stream_party_wrappers",
+			Handler:       _MServiceAddrbook_StreamPartyWrappers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "bulk_upsert_party_wrappers",
+			Handler:       _MServiceAddrbook_BulkUpsertPartyWrappers_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "MServiceAddrbook.proto",
 }
@@ -0,0 +1 @@
+CREATE DATABASE addrbook ENCODING 'UTF8';
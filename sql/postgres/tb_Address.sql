@@ -0,0 +1,42 @@
+-- reference schema of the table as first created, the same as migration 0001_initial; it drops the
+-- table, so run addrserver migrate up rather than this script to create or upgrade a database
+
+DROP TABLE IF EXISTS tb_Address;
+
+-- address book address entity
+CREATE TABLE tb_Address
+(
+
+    -- party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- type of address record, int value of AddressType
+    intAddressType INTEGER NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INTEGER NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- postal address line 1
+    chvAddress1 VARCHAR(100) NOT NULL,
+    -- postal address line 2
+    chvAddress2 VARCHAR(100) NOT NULL,
+    -- postal city
+    chvCity VARCHAR(50) NOT NULL,
+    -- postal state
+    chvState VARCHAR(50) NOT NULL,
+    -- postal code
+    chvPostalCode VARCHAR(20) NOT NULL,
+    -- country code
+    chvCountryCode CHAR(2) NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId,intAddressType)
+);
+
@@ -0,0 +1,43 @@
+-- reference schema of the table as first created, the same as migration 0001_initial; it drops the
+-- table, so run addrserver migrate up rather than this script to create or upgrade a database
+
+DROP TABLE IF EXISTS tb_Party;
+
+-- address book party entity
+CREATE TABLE tb_Party
+(
+
+    -- party identifier
+    inbPartyId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INTEGER NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- type of party record, int value of PartyType
+    intPartyType INTEGER NOT NULL,
+    -- party last name
+    chvLastName VARCHAR(50) NOT NULL,
+    -- party middle name
+    chvMiddleName VARCHAR(50) NOT NULL,
+    -- party first name
+    chvFirstName VARCHAR(50) NOT NULL,
+    -- party nickname
+    chvNickname VARCHAR(50) NOT NULL,
+    -- party company
+    chvCompany VARCHAR(100) NOT NULL,
+    -- party email
+    chvEmail VARCHAR(50) NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId),
+    UNIQUE (inbMserviceId,inbPartyId)
+);
+
@@ -0,0 +1,32 @@
+-- reference schema of the table as first created, the same as migration 0001_initial; it drops the
+-- table, so run addrserver migrate up rather than this script to create or upgrade a database
+
+DROP TABLE IF EXISTS tb_Phone;
+
+-- address book phone entity
+CREATE TABLE tb_Phone
+(
+
+    -- party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- type of phone record, int value of PhoneType
+    intPhoneType INTEGER NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INTEGER NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- phone number
+    chvPhoneNumber VARCHAR(20) NOT NULL,
+
+
+    PRIMARY KEY (inbPartyId,intPhoneType)
+);
+
@@ -1,3 +1,6 @@
+-- reference schema of the table as first created, the same as migration 0001_initial; it drops the
+-- table, so run addrserver migrate up rather than this script to create or upgrade a database
+
 use addrbook;
 
 DROP TABLE IF EXISTS tb_Phone;